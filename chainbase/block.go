@@ -2,14 +2,17 @@ package chainbase
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/iost-official/go-iost/v3/common"
 	"github.com/iost-official/go-iost/v3/consensus/cverifier"
 	"github.com/iost-official/go-iost/v3/core/block"
 	"github.com/iost-official/go-iost/v3/core/blockcache"
+	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/ilog"
 	"github.com/iost-official/go-iost/v3/verifier"
+	"github.com/iost-official/go-iost/v3/vm/host"
 )
 
 var (
@@ -22,6 +25,38 @@ var (
 	errDelayTx    = errors.New("delaytx is not allowed")
 )
 
+// invalidBlockError marks the error which proves the block is invalid, rather than depends on the local state of node,
+// such as the clock, the speed of execution and the limit of slot.
+type invalidBlockError struct {
+	err error
+}
+
+func (e *invalidBlockError) Error() string {
+	return e.err.Error()
+}
+
+func (e *invalidBlockError) Unwrap() error {
+	return e.err
+}
+
+func invalidBlock(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &invalidBlockError{err: err}
+}
+
+// ChildBlockError is returned by Add when the block is added, but some of its descendants which are received
+// before it fail in adding, Errs[i] is the error of Blocks[i].
+type ChildBlockError struct {
+	Blocks []*block.Block
+	Errs   []error
+}
+
+func (e *ChildBlockError) Error() string {
+	return fmt.Sprintf("%v child blocks failed in verification, the first error: %v", len(e.Blocks), e.Errs[0])
+}
+
 // Block will describe the block of chainbase.
 type Block struct {
 	*block.Block
@@ -111,27 +146,52 @@ func (c *ChainBase) printStatistics(num int64, blk *block.Block, replay bool, ge
 	)
 }
 
+// IsInvalidBlock returns whether the error of Add proves the block is invalid, such as a wrong signature or receipt,
+// so that the sender of block can be penalized.
+func IsInvalidBlock(err error) bool {
+	var e *invalidBlockError
+	return errors.As(err, &e)
+}
+
 // Add will add a block to block cache and verify it.
 func (c *ChainBase) Add(blk *block.Block, replay bool, gen bool) error {
+	return c.add(blk, replay, gen, nil)
+}
+
+// AddGenerated adds the block generated by the node, with the state diffs recorded in generation.
+func (c *ChainBase) AddGenerated(blk *block.Block, stateDiffs map[string][]*tx.StateChange) error {
+	return c.add(blk, false, true, stateDiffs)
+}
+
+func (c *ChainBase) add(blk *block.Block, replay bool, gen bool, stateDiffs map[string][]*tx.StateChange) error {
 	// ilog.Debug("add block ", blk.Head.Number, " to chain base")
 	_, err := c.bCache.GetBlockByHash(blk.HeadHash())
 	if err == nil {
 		return errDuplicate
 	}
 
-	err = blk.VerifySelf()
+	// the receipts of the block reconstructed from a compact block are computed in verification
+	if blk.Receipts == nil {
+		err = blk.VerifySign()
+	} else {
+		err = blk.VerifySelf()
+	}
 	if err != nil {
 		ilog.Warnf("Verify block basics failed: %v", err)
-		return err
+		return invalidBlock(err)
 	}
 
 	node := c.bCache.Add(blk)
+	node.StateDiffs = stateDiffs
 	parent := node.GetParent()
 	if parent.Type != blockcache.Linked {
 		return errSingle
 	}
 	if err := c.addExistingBlock(node, replay, gen); err != nil {
-		ilog.Warnf("verify block execute failed, blockNum: %v, blockHash: %v, err: %v", node.Head.Number, common.Base58Encode(node.HeadHash()), err)
+		var childErr *ChildBlockError
+		if !errors.As(err, &childErr) {
+			ilog.Warnf("verify block execute failed, blockNum: %v, blockHash: %v, err: %v", node.Head.Number, common.Base58Encode(node.HeadHash()), err)
+		}
 		return err
 	}
 
@@ -155,13 +215,14 @@ func (c *ChainBase) addExistingBlock(node *blockcache.BlockCacheNode, replay boo
 	ok := c.stateDB.Checkout(string(blk.HeadHash()))
 	if !ok {
 		c.stateDB.Checkout(string(blk.Head.ParentHash))
-		err := c.verifyBlock(blk, parentNode.Block, node.GetParent().WitnessList)
+		stateDiffs, err := c.verifyBlock(blk, parentNode.Block, node.GetParent().WitnessList)
 		if err != nil {
 			// TODO: Decouple add and link of blockcache, then remove the Del().
 			c.bCache.Del(node)
 			return err
 		}
 		c.stateDB.Commit(string(blk.HeadHash()))
+		node.StateDiffs = stateDiffs
 	}
 	c.bCache.Link(node)
 	if !replay {
@@ -177,30 +238,48 @@ func (c *ChainBase) addExistingBlock(node *blockcache.BlockCacheNode, replay boo
 
 	c.printStatistics(node.SerialNum, node.Block, replay, gen)
 
+	childErr := &ChildBlockError{}
 	for child := range node.Children {
-		if err := c.addExistingBlock(child, replay, gen); err != nil {
-			ilog.Warnf("verify block execute failed, blockNum: %v, blockHash: %v, err: %v", node.Head.Number, common.Base58Encode(node.HeadHash()), err)
+		err := c.addExistingBlock(child, replay, gen)
+		if err == nil {
+			continue
 		}
+		var e *ChildBlockError
+		if errors.As(err, &e) {
+			childErr.Blocks = append(childErr.Blocks, e.Blocks...)
+			childErr.Errs = append(childErr.Errs, e.Errs...)
+			continue
+		}
+		ilog.Warnf("verify block execute failed, blockNum: %v, blockHash: %v, err: %v", child.Head.Number, common.Base58Encode(child.HeadHash()), err)
+		childErr.Blocks = append(childErr.Blocks, child.Block)
+		childErr.Errs = append(childErr.Errs, err)
+	}
+	if len(childErr.Blocks) > 0 {
+		return childErr
 	}
 	return nil
 }
 
-func (c *ChainBase) verifyBlock(blk, parent *block.Block, witnessList *blockcache.WitnessList) error {
+// verifyBlock verifies the block on the state of parent, and returns the state diffs of txs if they are recorded.
+func (c *ChainBase) verifyBlock(blk, parent *block.Block, witnessList *blockcache.WitnessList) (map[string][]*tx.StateChange, error) {
 	err := cverifier.VerifyBlockHead(blk, parent)
+	if err == cverifier.ErrFutureBlk {
+		return nil, err
+	}
 	if err != nil {
-		return err
+		return nil, invalidBlock(err)
 	}
 
 	if common.WitnessOfNanoSec(blk.Head.Time, witnessList.Active()) != blk.Head.Witness {
 		ilog.Errorf("verifyBlock wrong witness: blk num: %v, time: %v, witness: %v, witness len: %v, witness list: %v",
 			blk.Head.Number, blk.Head.Time, blk.Head.Witness, len(witnessList.Active()), witnessList.Active())
-		return errWitness
+		return nil, invalidBlock(errWitness)
 	}
 	ilog.Debugf("[pob] start to verify block if foundchain, number: %v, hash = %v, witness = %v", blk.Head.Number, common.Base58Encode(blk.HeadHash()), blk.Head.Witness[4:6])
 	blkTxSet := make(map[string]bool, len(blk.Txs))
 	for i, t := range blk.Txs {
 		if blkTxSet[string(t.Hash())] {
-			return errDoubleTx
+			return nil, invalidBlock(errDoubleTx)
 		}
 		blkTxSet[string(t.Hash())] = true
 
@@ -208,27 +287,42 @@ func (c *ChainBase) verifyBlock(blk, parent *block.Block, witnessList *blockcach
 			// base tx
 			continue
 		}
-		// reject delay tx
-		if t.Delay > 0 {
-			return errDelayTx
+		// reject delay tx before fork 3.11.0
+		if t.Delay > 0 && !blk.Head.Rules().IsFork3_11_0 {
+			return nil, invalidBlock(errDelayTx)
 		}
 		if c.txPool.ExistTxs(t.Hash(), parent) {
 			ilog.Infof("FoundChain: %v, %v", t, common.Base58Encode(t.Hash()))
-			return errTxDup
+			return nil, invalidBlock(errTxDup)
 		}
 		err := t.VerifySelf()
 		if err != nil {
-			return err
+			return nil, invalidBlock(err)
 		}
 	}
 	v := verifier.Verifier{}
 	if c.config.SPV != nil && c.config.SPV.IsSPV {
-		// in SPV mode, only verify the block structure, not exec the txs
-		return nil
+		// in SPV mode, only verify the block structure, not exec the txs, since the node has no state before SyncFromBlock
+		return nil, nil
 	}
-	return v.Verify(blk, parent, witnessList, c.stateDB, &verifier.Config{
+	limits := host.ReadBlockLimits(c.stateDB, blk.Head.Rules())
+	vc := &verifier.Config{
 		Mode:        0,
-		Timeout:     common.MaxBlockTimeLimit,
-		TxTimeLimit: common.MaxTxTimeLimit,
-	})
+		Timeout:     limits.BlockTimeLimit,
+		TxTimeLimit: limits.TxTimeLimit,
+	}
+	if c.config.VM != nil {
+		vc.Thread = c.config.VM.Thread
+		if c.config.VM.StateDiff {
+			vc.StateDiffs = make(map[string][]*tx.StateChange)
+		}
+	}
+	err = v.Verify(blk, parent, witnessList, c.stateDB, vc)
+	if errors.Is(err, verifier.ErrReceiptMismatch) || errors.Is(err, verifier.ErrReceiptMerkleHash) {
+		return nil, invalidBlock(err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return vc.StateDiffs, nil
 }
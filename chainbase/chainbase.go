@@ -9,6 +9,7 @@ import (
 	"github.com/iost-official/go-iost/v3/core/blockcache"
 	"github.com/iost-official/go-iost/v3/core/txpool"
 	"github.com/iost-official/go-iost/v3/db"
+	"github.com/iost-official/go-iost/v3/db/kv"
 	"github.com/iost-official/go-iost/v3/ilog"
 )
 
@@ -26,12 +27,25 @@ type ChainBase struct {
 
 // New will return a ChainBase.
 func New(conf *common.Config) (*ChainBase, error) {
-	bChain, err := block.NewBlockChain(conf.DB.LdbPath + "BlockChainDB")
+	storageType, err := kv.ParseStorageType(conf.DB.Engine)
+	if err != nil {
+		return nil, fmt.Errorf("invalid db engine, stop the program. err: %v", err)
+	}
+	if storageType == kv.MemoryStorage {
+		ilog.Warnf("The memory db engine loses all data on exit.")
+	}
+
+	bChain, err := block.NewBlockChainWithStorage(conf.DB.LdbPath+"BlockChainDB", storageType)
 	if err != nil {
 		return nil, fmt.Errorf("new blockchain failed, stop the program. err: %v", err)
 	}
 
-	stateDB, err := db.NewMVCCDB(conf.DB.LdbPath + "StateDB")
+	var stateDB db.MVCCDB
+	if conf.DB.Archive {
+		stateDB, err = db.NewArchiveMVCCDB(conf.DB.LdbPath+"StateDB", storageType, conf.DB.ArchiveKeep)
+	} else {
+		stateDB, err = db.NewMVCCDBWithStorage(conf.DB.LdbPath+"StateDB", storageType)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("new statedb failed, stop the program. err: %v", err)
 	}
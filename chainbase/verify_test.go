@@ -1,11 +1,14 @@
 package chainbase
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/iost-official/go-iost/v3/account"
 	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/consensus/cverifier"
 	"github.com/iost-official/go-iost/v3/core/block"
 	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/crypto"
@@ -82,3 +85,21 @@ func TestVerifyBlock(t *testing.T) {
 		})
 	})
 }
+
+func TestIsInvalidBlock(t *testing.T) {
+	convey.Convey("Test of invalid block errors", t, func() {
+		convey.So(IsInvalidBlock(nil), convey.ShouldBeFalse)
+		convey.So(IsInvalidBlock(errDuplicate), convey.ShouldBeFalse)
+		convey.So(IsInvalidBlock(errSingle), convey.ShouldBeFalse)
+		convey.So(IsInvalidBlock(errOutOfLimit), convey.ShouldBeFalse)
+		convey.So(IsInvalidBlock(cverifier.ErrFutureBlk), convey.ShouldBeFalse)
+		convey.So(IsInvalidBlock(invalidBlock(nil)), convey.ShouldBeFalse)
+		convey.So(IsInvalidBlock(invalidBlock(errWitness)), convey.ShouldBeTrue)
+		convey.So(IsInvalidBlock(fmt.Errorf("verify block: %w", invalidBlock(errDoubleTx))), convey.ShouldBeTrue)
+		convey.So(errors.Is(invalidBlock(errDoubleTx), errDoubleTx), convey.ShouldBeTrue)
+
+		childErr := &ChildBlockError{Blocks: []*block.Block{{}}, Errs: []error{invalidBlock(errWitness)}}
+		convey.So(IsInvalidBlock(childErr), convey.ShouldBeFalse)
+		convey.So(IsInvalidBlock(childErr.Errs[0]), convey.ShouldBeTrue)
+	})
+}
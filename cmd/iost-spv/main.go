@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/ilog"
+	"github.com/iost-official/go-iost/v3/spv"
+	flag "github.com/spf13/pflag"
+)
+
+var (
+	configFile = flag.StringP("config", "f", "", "Configuration `file`, only the spv section is used")
+	server     = flag.StringP("server", "s", "", "Grpc address of the full node, overrides spv.seedserver")
+	from       = flag.Int64("from", 0, "Trusted block number to start from, overrides spv.syncfromblock")
+	dataPath   = flag.StringP("data", "d", "", "Dir to save verified headers, overrides spv.datapath")
+	txHash     = flag.String("tx", "", "Sync once and prove the tx with the `hash` is included in a verified block")
+	help       = flag.BoolP("help", "h", false, "Display available options")
+)
+
+func spvConfig() *common.SPVConfig {
+	conf := &common.SPVConfig{
+		SeedServer: "localhost:30002",
+		DataPath:   "spv/",
+	}
+	if *configFile != "" {
+		c := common.NewConfig(*configFile)
+		if c.SPV != nil {
+			conf = c.SPV
+		}
+	}
+	if *server != "" {
+		conf.SeedServer = *server
+	}
+	if *from != 0 {
+		conf.SyncFromBlock = *from
+	}
+	if *dataPath != "" {
+		conf.DataPath = *dataPath
+	}
+	return conf
+}
+
+func main() {
+	flag.Parse()
+	if *help {
+		flag.Usage()
+		return
+	}
+	conf := spvConfig()
+
+	source, err := spv.NewRPCSource(conf.SeedServer)
+	if err != nil {
+		ilog.Fatalf("connect to %v failed. err=%v", conf.SeedServer, err)
+	}
+	defer source.Close()
+
+	client, err := spv.NewClient(source, &spv.Config{
+		DataPath:      conf.DataPath,
+		SyncFromBlock: conf.SyncFromBlock,
+	})
+	if err != nil {
+		ilog.Fatalf("start spv client failed. err=%v", err)
+	}
+	defer client.Close()
+
+	if *txHash != "" {
+		if err := proveTx(client, *txHash); err != nil {
+			ilog.Errorf("prove tx failed. err=%v", err)
+			ilog.Stop()
+			os.Exit(1)
+		}
+		ilog.Stop()
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		c := make(chan os.Signal, 1)
+		signal.Notify(c, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
+		i := <-c
+		ilog.Infof("iost-spv received interrupt[%v], shutting down...", i)
+		cancel()
+	}()
+	client.Run(ctx)
+	ilog.Stop()
+}
+
+func proveTx(client *spv.Client, hash string) error {
+	if _, err := client.Sync(); err != nil {
+		return err
+	}
+	r, head, err := client.VerifyTx(common.Base58Decode(hash))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("tx %v is included in verified block %v\n", common.Base58Encode(r.TxHash), head.Number)
+	fmt.Printf("status: %v, gas usage: %v\n", r.Status.Code, r.GasUsage)
+	return nil
+}
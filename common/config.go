@@ -53,12 +53,24 @@ type GenesisConfig struct {
 // DBConfig config of the database
 type DBConfig struct {
 	LdbPath string
+	// Engine is the storage engine, "leveldb" (default), "pebble" or "memory" which loses all data on exit
+	Engine string
+	// Archive keeps the history state of irreversible blocks for queries at a block number
+	Archive bool
+	// ArchiveKeep is the number of recent irreversible blocks whose state is kept in archive mode, 0 keeps all
+	ArchiveKeep int64
 }
 
 // VMConfig config of the v8vm
 type VMConfig struct {
 	JsPath   string
 	LogLevel string
+	// Thread is the number of txs executed in parallel in a block, txs are executed serially if it is less than 2
+	Thread int
+	// BlockTxLimit is the max number of txs in a generated block, the limit on chain is used if it is 0 or higher
+	BlockTxLimit int
+	// StateDiff records the state changes of txs executed by the node, they can be queried by rpc
+	StateDiff bool
 }
 
 // P2PConfig is the config for p2p network.
@@ -74,6 +86,16 @@ type P2PConfig struct {
 	BlackIP      []string
 	AdminPort    string
 	DisableMplex bool
+	// RateLimit overrides the default rate limits of incoming messages from one peer, by message type name, the fields not set keep the default
+	RateLimit map[string]*RateLimitConfig
+}
+
+// RateLimitConfig is the token bucket limit of a message type from one peer.
+type RateLimitConfig struct {
+	Rate  float64 // messages per second
+	Burst int
+	// Action to the message exceeding the limit: drop, throttle (wait for the token) or disconnect
+	Action string
 }
 
 // RPCConfig is the config for RPC Server.
@@ -118,8 +140,17 @@ type MetricsConfig struct {
 
 // SnapshotConfig is the config of snapshot
 type SnapshotConfig struct {
-	Enable   bool
+	Enable bool
+	// FilePath is the snapshot file written by ToFile, and read by FromFile.
 	FilePath string
+	// SkipVerify is whether to restore the snapshot without checking its head block in the local block chain db.
+	SkipVerify bool
+	// Serve is whether to offer the snapshot file of FilePath to other nodes through p2p, whose block should be irreversible.
+	Serve bool
+	// Sync is whether to download a snapshot to FilePath from other nodes when the node has no data.
+	Sync bool
+	// TrustedHash is the base58 manifest hash of the snapshot to download, it is required by Sync.
+	TrustedHash string
 }
 
 // DebugConfig is the config of debug.
@@ -134,9 +165,13 @@ type VersionConfig struct {
 }
 
 type SPVConfig struct {
+	// IsSPV runs iserver as a node which syncs from SyncFromBlock of SeedServer, and checks the structure
+	// and signatures of blocks without executing txs. It's not used by the iost-spv light client.
 	IsSPV         bool
 	SyncFromBlock int64
 	SeedServer    string
+	// DataPath is where the iost-spv light client keeps its verified headers
+	DataPath string
 }
 
 // Config provide all configuration for the application
@@ -9,7 +9,8 @@ import (
 )
 
 var (
-	errFutureBlk  = errors.New("block from future")
+	// ErrFutureBlk means the block time is ahead of local time, which may be caused by the local clock.
+	ErrFutureBlk  = errors.New("block from future")
 	errOldBlk     = errors.New("block time older than parent block")
 	errParentHash = errors.New("wrong parent hash")
 	errNumber     = errors.New("wrong number")
@@ -28,7 +29,7 @@ var (
 func VerifyBlockHead(blk *block.Block, parentBlock *block.Block) error {
 	bh := blk.Head
 	if bh.Time > time.Now().UnixNano()+MaxBlockTimeGap {
-		return errFutureBlk
+		return ErrFutureBlk
 	}
 	if bh.Time <= parentBlock.Head.Time {
 		return errOldBlk
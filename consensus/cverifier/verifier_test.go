@@ -39,7 +39,7 @@ func TestVerifyBlockHead(t *testing.T) {
 			convey.So(err, convey.ShouldEqual, errOldBlk)
 			blk.Head.Time = stamp + 10*1e9
 			err = VerifyBlockHead(blk, parentBlk)
-			convey.So(err, convey.ShouldEqual, errFutureBlk)
+			convey.So(err, convey.ShouldEqual, ErrFutureBlk)
 		})
 
 		convey.Convey("Wrong parent", func() {
@@ -1,6 +1,7 @@
 package pob
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -19,6 +20,7 @@ import (
 	"github.com/iost-official/go-iost/v3/metrics"
 	"github.com/iost-official/go-iost/v3/p2p"
 	"github.com/iost-official/go-iost/v3/verifier"
+	"github.com/iost-official/go-iost/v3/vm/host"
 )
 
 var (
@@ -49,6 +51,7 @@ type PoB struct {
 	wg         *sync.WaitGroup
 	mu         *sync.RWMutex
 	spvConf    *common.SPVConfig
+	vmConf     *common.VMConfig
 }
 
 // New init a new PoB.
@@ -79,6 +82,7 @@ func New(conf *common.Config, cBase *chainbase.ChainBase, p2pService p2p.Service
 		wg:         new(sync.WaitGroup),
 		mu:         new(sync.RWMutex),
 		spvConf:    conf.SPV,
+		vmConf:     conf.VM,
 	}
 
 	return &p
@@ -116,16 +120,36 @@ func (p *PoB) doVerifyBlock(blk *block.Block) {
 	p.mu.Lock()
 	err := p.cBase.Add(blk, false, false)
 	p.mu.Unlock()
+	// The block is added, but its children received before it are failed.
+	var childErr *chainbase.ChildBlockError
+	if errors.As(err, &childErr) {
+		for i, child := range childErr.Blocks {
+			p.handleFailedBlock(child, childErr.Errs[i])
+		}
+		err = nil
+	}
 	if err != nil {
+		p.handleFailedBlock(blk, err)
 		return
 	}
+	p.sync.ReportBlock(blk, true)
 
 	// TODO: Not all successful link blocks will go to this logic.
+	// The block hash is still broadcast for the nodes which don't support compact block.
 	if !p.sync.IsCatchingUp() {
+		p.sync.BroadcastCompactBlock(blk)
 		p.sync.BroadcastBlockInfo(blk)
 	}
 }
 
+// handleFailedBlock requests the full block if the block reconstructed from a compact block fails,
+// otherwise reports the sender if the block is invalid.
+func (p *PoB) handleFailedBlock(blk *block.Block, err error) {
+	if chainbase.IsInvalidBlock(err) && !p.sync.RequestFullBlock(blk) {
+		p.sync.ReportBlock(blk, false)
+	}
+}
+
 func (p *PoB) verifyLoop() {
 	for {
 		select {
@@ -158,19 +182,21 @@ func (p *PoB) doGenerateBlock(slot int64) {
 	p.mu.Lock()
 	for num := 0; num < common.BlockNumPerWitness; num++ {
 		<-time.After(time.Until(common.TimeOfBlock(slot, int64(num))))
-		blk, err := p.generateBlock(num, t1, witnessList)
+		blk, stateDiffs, err := p.generateBlock(num, t1, witnessList)
 		if err != nil {
 			ilog.Errorf("Generate block failed: %v", err)
 			// Maybe should break.
 			continue
 		}
-		p.sync.BroadcastBlock(blk)
-		err = p.cBase.Add(blk, false, true)
+		// The block is added before broadcast, so that the missing txs of the compact block can be requested.
+		err = p.cBase.AddGenerated(blk, stateDiffs)
 		if err != nil {
 			ilog.Errorf("[pob] handle block from myself, err:%v", err)
 			// Maybe should break.
 			continue
 		}
+		p.sync.BroadcastCompactBlock(blk)
+		p.sync.BroadcastBlockInfo(blk)
 	}
 	p.mu.Unlock()
 }
@@ -188,7 +214,7 @@ func (p *PoB) generateLoop() {
 	}
 }
 
-func (p *PoB) generateBlock(num int, t1 int64, oldWitnessList []string) (*block.Block, error) {
+func (p *PoB) generateBlock(num int, t1 int64, oldWitnessList []string) (*block.Block, map[string][]*tx.StateChange, error) {
 	t2 := time.Now().UnixNano()
 	defer func() {
 		// TODO: Confirm the most appropriate metrics definition.
@@ -204,11 +230,7 @@ func (p *PoB) generateBlock(num int, t1 int64, oldWitnessList []string) (*block.
 		oldWitnessListIndex := common.WitnessIndexOfNanoSec(t1, oldWitnessList)
 		ilog.Errorf("oldWitnessList %v, index %v, t %v", oldWitnessList, oldWitnessListIndex, t1)
 		ilog.Errorf("witnessList %v, index %v, t %v", witnessList, witnessIndex, t3.UnixNano())
-		return nil, fmt.Errorf("now time %v exceeding the slot of witness %v. num: %v, blk.num: %v", t2, p.account.ReadablePubkey(), num, head.Head.Number+1)
-	}
-	limitTime := common.MaxBlockTimeLimit
-	if num >= common.BlockNumPerWitness-2 {
-		limitTime = last2GenBlockTime
+		return nil, nil, fmt.Errorf("now time %v exceeding the slot of witness %v. num: %v, blk.num: %v", t2, p.account.ReadablePubkey(), num, head.Head.Number+1)
 	}
 	blk := &block.Block{
 		Head: &block.BlockHead{
@@ -224,20 +246,32 @@ func (p *PoB) generateBlock(num int, t1 int64, oldWitnessList []string) (*block.
 	}
 
 	p.produceDB.Checkout(string(head.HeadHash()))
+	limits := host.ReadBlockLimits(p.produceDB, blk.Head.Rules())
+	limitTime := limits.BlockTimeLimit
+	if num >= common.BlockNumPerWitness-2 && limitTime > last2GenBlockTime {
+		limitTime = last2GenBlockTime
+	}
 	v := &verifier.Executor{}
+	vc := &verifier.Config{
+		Mode:        0,
+		Timeout:     limitTime - time.Since(t3),
+		TxTimeLimit: limits.TxTimeLimit,
+	}
+	if p.vmConf != nil {
+		vc.Thread = p.vmConf.Thread
+		vc.BlockTxLimit = p.vmConf.BlockTxLimit
+		if p.vmConf.StateDiff {
+			vc.StateDiffs = make(map[string][]*tx.StateChange)
+		}
+	}
 	// TODO: stateDb and block head is consisdent, pTx may be inconsisdent.
 	dropList, _, err := v.Gen(
-		blk, head.Block, head.WitnessList, p.produceDB, pTx,
-		&verifier.Config{
-			Mode:        0,
-			Timeout:     limitTime - time.Since(t3),
-			TxTimeLimit: common.MaxTxTimeLimit,
-		},
+		blk, head.Block, head.WitnessList, p.produceDB, pTx, vc,
 	)
 	if err != nil {
 		// TODO: Maybe should synchronous
 		go p.delTxList(dropList)
-		return nil, err
+		return nil, nil, err
 	}
 	blk.Head.TxMerkleHash = blk.CalculateTxMerkleHash()
 	blk.Head.TxReceiptMerkleHash = blk.CalculateTxReceiptMerkleHash()
@@ -245,7 +279,7 @@ func (p *PoB) generateBlock(num int, t1 int64, oldWitnessList []string) (*block.
 	blk.Sign = p.account.Sign(blk.HeadHash())
 	p.produceDB.Commit(string(blk.HeadHash()))
 
-	return blk, nil
+	return blk, vc.StateDiffs, nil
 }
 
 func (p *PoB) delTxList(delList []*tx.Tx) {
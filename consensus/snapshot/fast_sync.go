@@ -0,0 +1,313 @@
+package snapshot
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/iost-official/go-iost/v3/common"
+	snapshotpb "github.com/iost-official/go-iost/v3/consensus/snapshot/pb"
+	msgpb "github.com/iost-official/go-iost/v3/consensus/synchro/pb"
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/db/kv"
+	"github.com/iost-official/go-iost/v3/ilog"
+	"github.com/iost-official/go-iost/v3/p2p"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	queryInterval       = 10 * time.Second
+	maxQueryTimes       = 30
+	blockRequestTimeout = 10 * time.Second
+	chunkRequestTimeout = 30 * time.Second
+	chunkCheckInterval  = time.Second
+	maxInflightChunks   = 16
+	maxPeerFailures     = 3
+
+	errNoTrustedHash     = errors.New("trusted hash should be configured for snapshot sync")
+	errNoSnapshotOffered = errors.New("no snapshot is offered by other nodes")
+	errNoSnapshotPeer    = errors.New("no peer serves the snapshot")
+)
+
+// offer is a snapshot offered by peers.
+type offer struct {
+	manifest *snapshotpb.Manifest
+	hash     []byte
+	peers    []p2p.PeerID
+}
+
+type chunkRequest struct {
+	peerID p2p.PeerID
+	time   time.Time
+}
+
+type fastSync struct {
+	conf    *common.Config
+	p       p2p.Service
+	trusted []byte
+
+	msgCh chan p2p.IncomingMessage
+}
+
+// FastSync downloads the snapshot offered by other nodes through p2p, and restores the state db and head block of it,
+// so that the node continues with block sync from the snapshot instead of genesis. It does nothing if the node has data.
+func FastSync(conf *common.Config) error {
+	if conf.Snapshot == nil || !conf.Snapshot.Sync {
+		return nil
+	}
+	for _, name := range []string{"StateDB", "BlockChainDB"} {
+		if _, err := os.Stat(filepath.Join(conf.DB.LdbPath, name)); err == nil {
+			ilog.Infof("%v already exists, skip snapshot sync.", name)
+			return nil
+		}
+	}
+	// The head block of snapshot can't be verified without the chain before it, so only the trusted one is synced.
+	if conf.Snapshot.TrustedHash == "" {
+		return errNoTrustedHash
+	}
+	trusted := common.Base58Decode(conf.Snapshot.TrustedHash)
+	if len(trusted) == 0 {
+		return fmt.Errorf("invalid trusted hash %v", conf.Snapshot.TrustedHash)
+	}
+
+	p, err := p2p.NewNetService(conf.P2P)
+	if err != nil {
+		return err
+	}
+	if err := p.Start(); err != nil {
+		return err
+	}
+	defer p.Stop()
+
+	f := &fastSync{
+		conf:    conf,
+		p:       p,
+		trusted: trusted,
+
+		msgCh: p.Register("snapshot sync", p2p.SnapshotOffer, p2p.SnapshotChunkResponse, p2p.SyncBlockResponse),
+	}
+	return f.run()
+}
+
+func (f *fastSync) run() error {
+	o, err := f.discover()
+	if err != nil {
+		return err
+	}
+	ilog.Infof("Syncing snapshot %v from %v peers, block number: %v, size: %v", common.Base58Encode(o.hash), len(o.peers), o.manifest.BlockNumber, o.manifest.Size)
+
+	blk, err := f.fetchBlock(o)
+	if err != nil {
+		return err
+	}
+	if err := f.download(o); err != nil {
+		return err
+	}
+	// The head block is pushed first, so that the state db is verified against it while restoring.
+	if err := pushBlock(f.conf, blk); err != nil {
+		os.RemoveAll(filepath.Join(f.conf.DB.LdbPath, "BlockChainDB"))
+		return err
+	}
+	if err := FromFile(f.conf); err != nil {
+		os.RemoveAll(filepath.Join(f.conf.DB.LdbPath, "BlockChainDB"))
+		return err
+	}
+	ilog.Infof("Synced snapshot %v, continue with block sync from block %v.", common.Base58Encode(o.hash), blk.Head.Number)
+	return nil
+}
+
+// discover queries the neighbors for the trusted snapshot.
+func (f *fastSync) discover() (*offer, error) {
+	for i := 0; i < maxQueryTimes; i++ {
+		f.p.Broadcast(nil, p2p.SnapshotQuery, p2p.NormalMessage)
+
+		offers := make(map[string]*offer)
+		deadline := time.After(queryInterval)
+	collect:
+		for {
+			select {
+			case msg := <-f.msgCh:
+				if msg.Type() != p2p.SnapshotOffer {
+					continue
+				}
+				manifest, err := decodeManifest(msg.Data())
+				if err != nil {
+					ilog.Warnf("Decode snapshot manifest from %v failed: %v", msg.From().String(), err)
+					f.p.ReportPeer(msg.From(), p2p.MalformedMessage)
+					continue
+				}
+				hash := common.Sha3(msg.Data())
+				if !bytes.Equal(hash, f.trusted) {
+					continue
+				}
+				o, ok := offers[string(hash)]
+				if !ok {
+					o = &offer{manifest: manifest, hash: hash}
+					offers[string(hash)] = o
+				}
+				o.peers = append(o.peers, msg.From())
+			case <-deadline:
+				break collect
+			}
+		}
+
+		var best *offer
+		for _, o := range offers {
+			if best == nil || len(o.peers) > len(best.peers) ||
+				(len(o.peers) == len(best.peers) && o.manifest.BlockNumber > best.manifest.BlockNumber) {
+				best = o
+			}
+		}
+		if best != nil {
+			return best, nil
+		}
+		ilog.Infof("Waiting for snapshot offers from other nodes.")
+	}
+	return nil, errNoSnapshotOffered
+}
+
+// fetchBlock requests the head block of snapshot from the peers offering it.
+func (f *fastSync) fetchBlock(o *offer) (*block.Block, error) {
+	req, err := proto.Marshal(&msgpb.BlockInfo{Hash: o.manifest.BlockHash, Number: -1})
+	if err != nil {
+		return nil, err
+	}
+	for _, peerID := range o.peers {
+		f.p.SendToPeer(peerID, req, p2p.SyncBlockRequest, p2p.NormalMessage)
+		deadline := time.After(blockRequestTimeout)
+	wait:
+		for {
+			select {
+			case msg := <-f.msgCh:
+				if msg.Type() != p2p.SyncBlockResponse || msg.From() != peerID {
+					continue
+				}
+				blk := &block.Block{}
+				if err := blk.Decode(msg.Data()); err != nil || !bytes.Equal(blk.HeadHash(), o.manifest.BlockHash) {
+					f.p.ReportPeer(peerID, p2p.MalformedMessage)
+					break wait
+				}
+				if err := blk.VerifySelf(); err != nil {
+					ilog.Warnf("Verify head block of snapshot failed: %v", err)
+					f.p.ReportPeer(peerID, p2p.InvalidBlock)
+					break wait
+				}
+				return blk, nil
+			case <-deadline:
+				f.p.ReportPeer(peerID, p2p.SyncTimeout)
+				break wait
+			}
+		}
+	}
+	return nil, fmt.Errorf("head block %v of snapshot is not found", common.Base58Encode(o.manifest.BlockHash))
+}
+
+// download requests the chunks from the peers offering the snapshot in turn, and writes the verified ones to file.
+// The peer is no longer requested after it fails maxPeerFailures times, and it fails if no peer is left.
+func (f *fastSync) download(o *offer) error {
+	file, err := os.OpenFile(f.conf.Snapshot.FilePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0666)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	total := int64(len(o.manifest.ChunkHashes))
+	queue := make([]int64, 0, total)
+	for i := int64(0); i < total; i++ {
+		queue = append(queue, i)
+	}
+	inflight := make(map[int64]*chunkRequest)
+	remaining := total
+	peers := append([]p2p.PeerID{}, o.peers...)
+	failures := make(map[p2p.PeerID]int)
+	fail := func(peerID p2p.PeerID) {
+		failures[peerID]++
+		if failures[peerID] == maxPeerFailures {
+			ilog.Warnf("Stop requesting snapshot chunks from %v, it failed %v times.", peerID.String(), maxPeerFailures)
+			peers = slices.DeleteFunc(peers, func(p p2p.PeerID) bool { return p == peerID })
+		}
+	}
+	turn := 0
+	ticker := time.NewTicker(chunkCheckInterval)
+	defer ticker.Stop()
+
+	for remaining > 0 {
+		if len(peers) == 0 {
+			return errNoSnapshotPeer
+		}
+		for len(inflight) < maxInflightChunks && len(queue) > 0 {
+			index := queue[0]
+			queue = queue[1:]
+			peerID := peers[turn%len(peers)]
+			turn++
+			req, err := proto.Marshal(&snapshotpb.ChunkRequest{ManifestHash: o.hash, Index: index})
+			if err != nil {
+				return err
+			}
+			f.p.SendToPeer(peerID, req, p2p.SnapshotChunkRequest, p2p.NormalMessage)
+			inflight[index] = &chunkRequest{peerID: peerID, time: time.Now()}
+		}
+
+		select {
+		case msg := <-f.msgCh:
+			if msg.Type() != p2p.SnapshotChunkResponse {
+				continue
+			}
+			resp := &snapshotpb.ChunkResponse{}
+			if err := proto.Unmarshal(msg.Data(), resp); err != nil {
+				f.p.ReportPeer(msg.From(), p2p.MalformedMessage)
+				continue
+			}
+			req, ok := inflight[resp.Index]
+			if !ok || req.peerID != msg.From() || !bytes.Equal(resp.ManifestHash, o.hash) {
+				continue
+			}
+			delete(inflight, resp.Index)
+			if err := verifyChunk(o.manifest, resp.Index, resp.Data); err != nil {
+				ilog.Warnf("Verify snapshot chunk from %v failed: %v", msg.From().String(), err)
+				f.p.ReportPeer(msg.From(), p2p.MalformedMessage)
+				fail(msg.From())
+				queue = append(queue, resp.Index)
+				continue
+			}
+			if _, err := file.WriteAt(resp.Data, resp.Index*o.manifest.ChunkSize); err != nil {
+				return err
+			}
+			remaining--
+			if remaining%100 == 0 {
+				ilog.Infof("Snapshot sync progress: %v/%v chunks", total-remaining, total)
+			}
+		case <-ticker.C:
+			for index, req := range inflight {
+				if time.Since(req.time) > chunkRequestTimeout {
+					delete(inflight, index)
+					f.p.ReportPeer(req.peerID, p2p.SyncTimeout)
+					fail(req.peerID)
+					queue = append(queue, index)
+				}
+			}
+		}
+	}
+	return file.Sync()
+}
+
+// pushBlock saves the head block of snapshot as the first block of block chain db.
+func pushBlock(conf *common.Config, blk *block.Block) error {
+	storageType, err := kv.ParseStorageType(conf.DB.Engine)
+	if err != nil {
+		return err
+	}
+	bChain, err := block.NewBlockChainWithStorage(conf.DB.LdbPath+"BlockChainDB", storageType)
+	if err != nil {
+		return err
+	}
+	defer bChain.Close()
+	if bChain.Length() != 0 {
+		return errors.New("block chain db is not empty")
+	}
+	return bChain.Push(blk)
+}
@@ -0,0 +1,204 @@
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// The snapshot file is a stream of length-prefixed records:
+//
+//	header:  magic | version(uint32) | block number(int64) | uvarint len | block hash
+//	records: uvarint len | key | uvarint len | value   (repeated, key is never empty)
+//	trailer: uvarint 0 | record count(uint64) | sha3-256 of the header, the records and the terminating 0
+const (
+	fileMagic   = "IOSTSNAP"
+	fileVersion = uint32(1)
+
+	maxRecordLength = 64 * 1024 * 1024
+)
+
+// error of snapshot file
+var (
+	ErrInvalidMagic    = errors.New("invalid snapshot file magic")
+	ErrInvalidVersion  = errors.New("unsupported snapshot file version")
+	ErrInvalidChecksum = errors.New("snapshot checksum mismatch")
+	ErrRecordTooLarge  = errors.New("snapshot record too large")
+)
+
+// FileHeader is the header of snapshot file.
+type FileHeader struct {
+	BlockNumber int64
+	BlockHash   []byte
+}
+
+type fileWriter struct {
+	w     *bufio.Writer
+	hash  hash.Hash
+	count uint64
+	buf   [binary.MaxVarintLen64]byte
+}
+
+func newFileWriter(w io.Writer, header *FileHeader) (*fileWriter, error) {
+	fw := &fileWriter{
+		w:    bufio.NewWriterSize(w, 100000),
+		hash: sha3.New256(),
+	}
+	hw := io.MultiWriter(fw.w, fw.hash)
+	if _, err := io.WriteString(hw, fileMagic); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(hw, binary.BigEndian, fileVersion); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(hw, binary.BigEndian, header.BlockNumber); err != nil {
+		return nil, err
+	}
+	if err := fw.writeBytes(hw, header.BlockHash); err != nil {
+		return nil, err
+	}
+	return fw, nil
+}
+
+func (fw *fileWriter) writeBytes(w io.Writer, b []byte) error {
+	n := binary.PutUvarint(fw.buf[:], uint64(len(b)))
+	if _, err := w.Write(fw.buf[:n]); err != nil {
+		return err
+	}
+	_, err := w.Write(b)
+	return err
+}
+
+// Write appends a key-value record.
+func (fw *fileWriter) Write(key, value []byte) error {
+	if len(key) == 0 {
+		return errors.New("empty key is not allowed in snapshot")
+	}
+	w := io.MultiWriter(fw.w, fw.hash)
+	if err := fw.writeBytes(w, key); err != nil {
+		return err
+	}
+	if err := fw.writeBytes(w, value); err != nil {
+		return err
+	}
+	fw.count++
+	return nil
+}
+
+// Close writes the trailer and flushes the buffer.
+func (fw *fileWriter) Close() error {
+	if err := fw.writeBytes(io.MultiWriter(fw.w, fw.hash), nil); err != nil {
+		return err
+	}
+	if err := binary.Write(fw.w, binary.BigEndian, fw.count); err != nil {
+		return err
+	}
+	if _, err := fw.w.Write(fw.hash.Sum(nil)); err != nil {
+		return err
+	}
+	return fw.w.Flush()
+}
+
+type fileReader struct {
+	r      *bufio.Reader
+	hash   hash.Hash
+	count  uint64
+	header *FileHeader
+}
+
+func newFileReader(r io.Reader) (*fileReader, error) {
+	fr := &fileReader{
+		r:    bufio.NewReaderSize(r, 100000),
+		hash: sha3.New256(),
+	}
+	hr := io.TeeReader(fr.r, fr.hash)
+	magic := make([]byte, len(fileMagic))
+	if _, err := io.ReadFull(hr, magic); err != nil {
+		return nil, fmt.Errorf("read magic failed: %v", err)
+	}
+	if string(magic) != fileMagic {
+		return nil, ErrInvalidMagic
+	}
+	var version uint32
+	if err := binary.Read(hr, binary.BigEndian, &version); err != nil {
+		return nil, fmt.Errorf("read version failed: %v", err)
+	}
+	if version != fileVersion {
+		return nil, ErrInvalidVersion
+	}
+	header := &FileHeader{}
+	if err := binary.Read(hr, binary.BigEndian, &header.BlockNumber); err != nil {
+		return nil, fmt.Errorf("read block number failed: %v", err)
+	}
+	blockHash, err := fr.readBytes(fr.hash)
+	if err != nil {
+		return nil, fmt.Errorf("read block hash failed: %v", err)
+	}
+	header.BlockHash = blockHash
+	fr.header = header
+	return fr, nil
+}
+
+func (fr *fileReader) readBytes(w io.Writer) ([]byte, error) {
+	l, err := binary.ReadUvarint(fr.r)
+	if err != nil {
+		return nil, err
+	}
+	if l > maxRecordLength {
+		return nil, ErrRecordTooLarge
+	}
+	if w != nil {
+		var buf [binary.MaxVarintLen64]byte
+		n := binary.PutUvarint(buf[:], l)
+		w.Write(buf[:n])
+	}
+	b := make([]byte, l)
+	if _, err := io.ReadFull(fr.r, b); err != nil {
+		return nil, err
+	}
+	if w != nil {
+		w.Write(b)
+	}
+	return b, nil
+}
+
+// Next returns the next record. It returns io.EOF after the trailer is read and verified.
+func (fr *fileReader) Next() ([]byte, []byte, error) {
+	key, err := fr.readBytes(fr.hash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read key failed: %v", err)
+	}
+	if len(key) == 0 {
+		return nil, nil, fr.verifyTrailer()
+	}
+	value, err := fr.readBytes(fr.hash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read value failed: %v", err)
+	}
+	fr.count++
+	return key, value, nil
+}
+
+func (fr *fileReader) verifyTrailer() error {
+	var count uint64
+	if err := binary.Read(fr.r, binary.BigEndian, &count); err != nil {
+		return fmt.Errorf("read record count failed: %v", err)
+	}
+	if count != fr.count {
+		return fmt.Errorf("record count mismatch, expect %v, got %v", count, fr.count)
+	}
+	sum := make([]byte, fr.hash.Size())
+	if _, err := io.ReadFull(fr.r, sum); err != nil {
+		return fmt.Errorf("read checksum failed: %v", err)
+	}
+	if !bytes.Equal(sum, fr.hash.Sum(nil)) {
+		return ErrInvalidChecksum
+	}
+	return io.EOF
+}
@@ -0,0 +1,89 @@
+package snapshot
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/iost-official/go-iost/v3/common"
+	snapshotpb "github.com/iost-official/go-iost/v3/consensus/snapshot/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// The snapshot file is transferred between nodes in chunks. Its manifest lists the hashes of chunks,
+// and the hash of the encoded manifest identifies the snapshot, which can be given as the trusted hash.
+var (
+	chunkSize    int64 = 1024 * 1024
+	maxChunkSize int64 = 4 * 1024 * 1024
+
+	errInvalidManifest = errors.New("invalid snapshot manifest")
+)
+
+// buildManifest reads the snapshot file, and returns the encoded manifest of it.
+func buildManifest(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	reader, err := newFileReader(f)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	manifest := &snapshotpb.Manifest{
+		BlockNumber: reader.header.BlockNumber,
+		BlockHash:   reader.header.BlockHash,
+		ChunkSize:   chunkSize,
+	}
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := io.ReadFull(f, buf)
+		if n > 0 {
+			manifest.Size += int64(n)
+			manifest.ChunkHashes = append(manifest.ChunkHashes, common.Sha3(buf[:n]))
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return proto.Marshal(manifest)
+}
+
+// decodeManifest decodes and checks the manifest received from other nodes.
+func decodeManifest(data []byte) (*snapshotpb.Manifest, error) {
+	manifest := &snapshotpb.Manifest{}
+	if err := proto.Unmarshal(data, manifest); err != nil {
+		return nil, err
+	}
+	if len(manifest.BlockHash) == 0 || manifest.Size <= 0 || manifest.ChunkSize <= 0 || manifest.ChunkSize > maxChunkSize {
+		return nil, errInvalidManifest
+	}
+	if int64(len(manifest.ChunkHashes)) != (manifest.Size+manifest.ChunkSize-1)/manifest.ChunkSize {
+		return nil, errInvalidManifest
+	}
+	return manifest, nil
+}
+
+// verifyChunk checks the length and hash of the chunk by manifest.
+func verifyChunk(manifest *snapshotpb.Manifest, index int64, data []byte) error {
+	if index < 0 || index >= int64(len(manifest.ChunkHashes)) {
+		return fmt.Errorf("chunk index %v out of range", index)
+	}
+	length := min(manifest.ChunkSize, manifest.Size-index*manifest.ChunkSize)
+	if int64(len(data)) != length {
+		return fmt.Errorf("chunk %v length mismatch, expect %v, got %v", index, length, len(data))
+	}
+	if !bytes.Equal(common.Sha3(data), manifest.ChunkHashes[index]) {
+		return fmt.Errorf("chunk %v hash mismatch", index)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package snapshot
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/iost-official/go-iost/v3/common"
+	snapshotpb "github.com/iost-official/go-iost/v3/consensus/snapshot/pb"
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/ilog"
+	"github.com/iost-official/go-iost/v3/p2p"
+	"google.golang.org/protobuf/proto"
+)
+
+var errNotIrreversible = errors.New("snapshot is not at an irreversible block")
+
+// Server offers the snapshot file to other nodes, and serves the chunks of it.
+// The snapshot should be at an irreversible block of the local block chain.
+type Server struct {
+	path   string
+	p      p2p.Service
+	bChain block.Chain
+
+	manifest     *snapshotpb.Manifest
+	manifestData []byte
+	manifestHash []byte
+
+	msgCh chan p2p.IncomingMessage
+
+	quitCh chan struct{}
+	done   *sync.WaitGroup
+}
+
+// NewServer returns a snapshot server of the file in config.
+func NewServer(conf *common.SnapshotConfig, p p2p.Service, bChain block.Chain) *Server {
+	return &Server{
+		path:   conf.FilePath,
+		p:      p,
+		bChain: bChain,
+
+		msgCh: p.Register("snapshot request", p2p.SnapshotQuery, p2p.SnapshotChunkRequest),
+
+		quitCh: make(chan struct{}),
+		done:   new(sync.WaitGroup),
+	}
+}
+
+// Start builds the manifest of snapshot file and starts serving.
+func (s *Server) Start() error {
+	data, err := buildManifest(s.path)
+	if err != nil {
+		return err
+	}
+	manifest, err := decodeManifest(data)
+	if err != nil {
+		return err
+	}
+	hash, err := s.bChain.GetHashByNumber(manifest.BlockNumber)
+	if err != nil || !bytes.Equal(hash, manifest.BlockHash) {
+		return fmt.Errorf("%v: block number %v, block hash %v", errNotIrreversible, manifest.BlockNumber, common.Base58Encode(manifest.BlockHash))
+	}
+	s.manifest = manifest
+	s.manifestData = data
+	s.manifestHash = common.Sha3(data)
+	ilog.Infof("Serving snapshot %v, block number: %v, block hash: %v", common.Base58Encode(s.manifestHash), manifest.BlockNumber, common.Base58Encode(manifest.BlockHash))
+
+	s.done.Add(1)
+	go s.controller()
+	return nil
+}
+
+// Stop stops serving.
+func (s *Server) Stop() {
+	close(s.quitCh)
+	s.done.Wait()
+	ilog.Infof("Stopped snapshot server.")
+}
+
+func (s *Server) handleChunkRequest(msg *p2p.IncomingMessage) {
+	req := &snapshotpb.ChunkRequest{}
+	if err := proto.Unmarshal(msg.Data(), req); err != nil {
+		ilog.Warnf("Unmarshal snapshot chunk request failed: %v", err)
+		s.p.ReportPeer(msg.From(), p2p.MalformedMessage)
+		return
+	}
+	if !bytes.Equal(req.ManifestHash, s.manifestHash) {
+		return
+	}
+	if req.Index < 0 || req.Index >= int64(len(s.manifest.ChunkHashes)) {
+		ilog.Warnf("Receive attack request from peer %v, chunk index: %v.", msg.From().String(), req.Index)
+		return
+	}
+
+	f, err := os.Open(s.path)
+	if err != nil {
+		ilog.Errorf("Open snapshot file failed: %v", err)
+		return
+	}
+	defer f.Close()
+	data := make([]byte, min(s.manifest.ChunkSize, s.manifest.Size-req.Index*s.manifest.ChunkSize))
+	if _, err := f.ReadAt(data, req.Index*s.manifest.ChunkSize); err != nil && err != io.EOF {
+		ilog.Errorf("Read snapshot chunk %v failed: %v", req.Index, err)
+		return
+	}
+
+	resp, err := proto.Marshal(&snapshotpb.ChunkResponse{
+		ManifestHash: s.manifestHash,
+		Index:        req.Index,
+		Data:         data,
+	})
+	if err != nil {
+		ilog.Errorf("Marshal snapshot chunk response failed: %v", err)
+		return
+	}
+	s.p.SendToPeer(msg.From(), resp, p2p.SnapshotChunkResponse, p2p.NormalMessage)
+}
+
+func (s *Server) controller() {
+	for {
+		select {
+		case msg := <-s.msgCh:
+			switch msg.Type() {
+			case p2p.SnapshotQuery:
+				s.p.SendToPeer(msg.From(), s.manifestData, p2p.SnapshotOffer, p2p.NormalMessage)
+			case p2p.SnapshotChunkRequest:
+				s.handleChunkRequest(&msg)
+			default:
+				ilog.Warnf("Unexcept snapshot message type: %v", msg.Type())
+			}
+		case <-s.quitCh:
+			s.done.Done()
+			return
+		}
+	}
+}
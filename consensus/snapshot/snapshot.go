@@ -2,7 +2,7 @@ package snapshot
 
 import (
 	"archive/tar"
-	"bufio"
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -13,7 +13,19 @@ import (
 	"compress/gzip"
 
 	"github.com/iost-official/go-iost/v3/common"
-	"github.com/iost-official/go-iost/v3/db/kv/leveldb"
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/db"
+	"github.com/iost-official/go-iost/v3/db/kv"
+	"github.com/iost-official/go-iost/v3/ilog"
+)
+
+const restoreBatchSize = 10000
+
+var (
+	stateTagKey = []byte(string(db.SEPARATOR) + "tag")
+
+	errNoBlockChainDB     = errors.New("block chain db not found")
+	errUnknownBlockNumber = errors.New("block number of snapshot is unknown")
 )
 
 /*
@@ -147,38 +159,165 @@ func FromSnapshot(conf *common.Config) error {
 	return nil
 }
 
-// ToFile the function for saving db to File.
+// ToFile the function for saving db to File, which is written to the FilePath of snapshot config.
 func ToFile(conf *common.Config) error {
 	src := filepath.Join(conf.DB.LdbPath, "StateDB")
 
 	if _, err := os.Stat(src); err != nil {
 		return fmt.Errorf("Unable to tar files - %v", err.Error())
 	}
-	db, err := leveldb.NewDB(src)
+	storageType, err := kv.ParseStorageType(conf.DB.Engine)
+	if err != nil {
+		return err
+	}
+	stateDB, err := kv.NewStorage(src, storageType)
 	if err != nil {
 		return err
 	}
-	defer db.Close()
+	defer stateDB.Close()
 
-	file, err := os.OpenFile(filepath.Join(conf.DB.LdbPath, "Snapshot.iost"), os.O_WRONLY|os.O_CREATE, 0666)
+	tag, err := stateDB.Get(stateTagKey)
+	if err != nil {
+		return err
+	}
+	header := &FileHeader{
+		BlockNumber: -1,
+		BlockHash:   tag,
+	}
+	if blk, err := headBlock(conf, storageType, tag); err == nil {
+		header.BlockNumber = blk.Head.Number
+	} else {
+		ilog.Warnf("Unable to get head block of snapshot: %v", err)
+	}
 
+	file, err := os.OpenFile(conf.Snapshot.FilePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0666)
 	if err != nil {
 		return err
 	}
 	defer file.Close()
-	iter := db.NewIteratorByPrefix([]byte("")).(*leveldb.Iter)
-	writer := bufio.NewWriterSize(file, 100000)
+
+	writer, err := newFileWriter(file, header)
+	if err != nil {
+		return err
+	}
+	iter := stateDB.NewIteratorByPrefix([]byte(""))
+	defer iter.Release()
 	for iter.Next() {
-		k := string(iter.Key())
-		v := string(iter.Value())
-		writer.WriteString(k + "\n")
-		writer.WriteString(v + "\n")
+		if err := writer.Write(iter.Key(), iter.Value()); err != nil {
+			return err
+		}
 	}
-	writer.Flush()
-	return nil
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	return writer.Close()
 }
 
 // FromFile the function for loading db from File.
-func FromFile() error {
+func FromFile(conf *common.Config) error {
+	dst := filepath.Join(conf.DB.LdbPath, "StateDB")
+
+	s, err := os.Stat(dst)
+	if err == nil && s.IsDir() {
+		return errors.New("state db already has")
+	}
+	storageType, err := kv.ParseStorageType(conf.DB.Engine)
+	if err != nil {
+		return err
+	}
+	fr, err := os.Open(conf.Snapshot.FilePath)
+	if err != nil {
+		return err
+	}
+	defer fr.Close()
+
+	reader, err := newFileReader(fr)
+	if err != nil {
+		return err
+	}
+	stateDB, err := kv.NewStorage(dst, storageType)
+	if err != nil {
+		return err
+	}
+	err = restore(stateDB, reader)
+	if err == nil {
+		err = verify(conf, storageType, stateDB, reader.header)
+	}
+	stateDB.Close()
+	if err != nil {
+		kv.RemoveStorage(dst, storageType)
+		return err
+	}
+	ilog.Infof("Restored state db from snapshot, block number: %v, hash: %v", reader.header.BlockNumber, common.Base58Encode(reader.header.BlockHash))
 	return nil
 }
+
+func restore(stateDB *kv.Storage, reader *fileReader) error {
+	if err := stateDB.BeginBatch(); err != nil {
+		return err
+	}
+	for n := 1; ; n++ {
+		key, value, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := stateDB.Put(key, value); err != nil {
+			return err
+		}
+		if n%restoreBatchSize == 0 {
+			if err := stateDB.CommitBatch(); err != nil {
+				return err
+			}
+			if err := stateDB.BeginBatch(); err != nil {
+				return err
+			}
+		}
+	}
+	return stateDB.CommitBatch()
+}
+
+func verify(conf *common.Config, storageType kv.StorageType, stateDB *kv.Storage, header *FileHeader) error {
+	tag, err := stateDB.Get(stateTagKey)
+	if err != nil {
+		return err
+	}
+	if len(tag) == 0 || !bytes.Equal(tag, header.BlockHash) {
+		return fmt.Errorf("state tag %v mismatch block hash %v in header", common.Base58Encode(tag), common.Base58Encode(header.BlockHash))
+	}
+	if header.BlockNumber < 0 {
+		return errUnknownBlockNumber
+	}
+	if conf.Snapshot.SkipVerify {
+		ilog.Warnf("Skip verifying the head block of snapshot.")
+		return nil
+	}
+	blk, err := headBlock(conf, storageType, tag)
+	if err != nil {
+		return err
+	}
+	if blk.Head.Number != header.BlockNumber {
+		return fmt.Errorf("block number mismatch, expect %v, got %v", header.BlockNumber, blk.Head.Number)
+	}
+	return nil
+}
+
+// headBlock returns the block of the state tag from the local block chain db.
+func headBlock(conf *common.Config, storageType kv.StorageType, tag []byte) (*block.Block, error) {
+	path := filepath.Join(conf.DB.LdbPath, "BlockChainDB")
+	if _, err := os.Stat(path); err != nil {
+		return nil, errNoBlockChainDB
+	}
+	bChain, err := block.NewBlockChainWithStorage(path, storageType)
+	if err != nil {
+		return nil, err
+	}
+	defer bChain.Close()
+	blk, err := bChain.GetBlockByHash(tag)
+	if err != nil {
+		return nil, fmt.Errorf("head block %v is not found in block chain db: %v", common.Base58Encode(tag), err)
+	}
+	return blk, nil
+}
@@ -5,9 +5,17 @@ import (
 	"math/rand"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/iost-official/go-iost/v3/common"
+	snapshotpb "github.com/iost-official/go-iost/v3/consensus/snapshot/pb"
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/crypto"
 	"github.com/iost-official/go-iost/v3/db"
+	"github.com/iost-official/go-iost/v3/db/kv"
+	"github.com/iost-official/go-iost/v3/p2p"
+	p2p_mock "github.com/iost-official/go-iost/v3/p2p/mocks"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -48,11 +56,264 @@ func TestSnapshot(t *testing.T) {
 		}
 		err = ToSnapshot(config)
 		So(err, ShouldBeNil)
+		os.RemoveAll("DB/StateDB/")
+		err = FromSnapshot(config)
+		So(err, ShouldBeNil)
+		config.Snapshot.FilePath = "DB/Snapshot.iost"
+		err = ToFile(config)
+		So(err, ShouldBeNil)
+	})
+}
+
+func TestFile(t *testing.T) {
+	Convey("Test of Snapshot File", t, func() {
+		os.RemoveAll("DB")
+		defer os.RemoveAll("DB")
+		blk := &block.Block{Head: &block.BlockHead{Number: 10}, Sign: &crypto.Signature{}}
+		blk.CalculateHeadHash()
+		tag := string(blk.HeadHash())
+		bChain, err := block.NewBlockChain("DB/BlockChainDB")
+		So(err, ShouldBeNil)
+		So(bChain.Push(blk), ShouldBeNil)
+		bChain.Close()
+
+		stateDB, err := db.NewMVCCDB("DB/StateDB")
+		So(err, ShouldBeNil)
+
+		kv := make(map[string]string)
+		for i := 0; i < 100; i++ {
+			k, v := randString(64), randString(32)+"\n"+randString(8)
+			kv[k] = v
+			err = stateDB.Put("state", k, v)
+			So(err, ShouldBeNil)
+		}
+		stateDB.Commit(tag)
+		stateDB.Flush(tag)
+		stateDB.Close()
+		config := &common.Config{
+			DB: &common.DBConfig{
+				LdbPath: "DB/",
+			},
+			Snapshot: &common.SnapshotConfig{
+				Enable:   true,
+				FilePath: "DB/state.iost",
+			},
+		}
 		err = ToFile(config)
 		So(err, ShouldBeNil)
+
+		Convey("restore", func() {
+			os.RemoveAll("DB/StateDB/")
+			err = FromFile(config)
+			So(err, ShouldBeNil)
+
+			stateDB, err := db.NewMVCCDB("DB/StateDB")
+			So(err, ShouldBeNil)
+			defer stateDB.Close()
+			So(stateDB.CurrentTag(), ShouldEqual, tag)
+			for k, v := range kv {
+				value, err := stateDB.Get("state", k)
+				So(err, ShouldBeNil)
+				So(value, ShouldEqual, v)
+			}
+		})
+
+		Convey("restore without block chain db", func() {
+			os.RemoveAll("DB/StateDB/")
+			os.RemoveAll("DB/BlockChainDB/")
+			err = FromFile(config)
+			So(err, ShouldEqual, errNoBlockChainDB)
+
+			config.Snapshot.SkipVerify = true
+			err = FromFile(config)
+			So(err, ShouldBeNil)
+		})
+
+		Convey("restore with unknown block number", func() {
+			os.RemoveAll("DB/BlockChainDB/")
+			err = ToFile(config)
+			So(err, ShouldBeNil)
+
+			os.RemoveAll("DB/StateDB/")
+			config.Snapshot.SkipVerify = true
+			err = FromFile(config)
+			So(err, ShouldEqual, errUnknownBlockNumber)
+		})
+
+		Convey("restore with broken file", func() {
+			data, err := os.ReadFile(config.Snapshot.FilePath)
+			So(err, ShouldBeNil)
+			data[len(data)/2] ^= 0xff
+			err = os.WriteFile(config.Snapshot.FilePath, data, 0666)
+			So(err, ShouldBeNil)
+
+			os.RemoveAll("DB/StateDB/")
+			err = FromFile(config)
+			So(err, ShouldNotBeNil)
+			_, err = os.Stat("DB/StateDB")
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+
+		Convey("restore with tampered header", func() {
+			data, err := os.ReadFile(config.Snapshot.FilePath)
+			So(err, ShouldBeNil)
+			// the last byte of block number, which follows the magic and version
+			data[len(fileMagic)+4+7]++
+			err = os.WriteFile(config.Snapshot.FilePath, data, 0666)
+			So(err, ShouldBeNil)
+
+			os.RemoveAll("DB/StateDB/")
+			config.Snapshot.SkipVerify = true
+			err = FromFile(config)
+			So(err, ShouldEqual, ErrInvalidChecksum)
+		})
+
+		Convey("restore to existing state db", func() {
+			err = FromFile(config)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestFileWithPebble(t *testing.T) {
+	Convey("Test of Snapshot File with pebble", t, func() {
+		os.RemoveAll("DB")
+		defer os.RemoveAll("DB")
+		blk := &block.Block{Head: &block.BlockHead{Number: 10}, Sign: &crypto.Signature{}}
+		blk.CalculateHeadHash()
+		tag := string(blk.HeadHash())
+		bChain, err := block.NewBlockChainWithStorage("DB/BlockChainDB", kv.PebbleStorage)
+		So(err, ShouldBeNil)
+		So(bChain.Push(blk), ShouldBeNil)
+		bChain.Close()
+
+		stateDB, err := db.NewMVCCDBWithStorage("DB/StateDB", kv.PebbleStorage)
+		So(err, ShouldBeNil)
+		values := make(map[string]string)
+		for i := 0; i < 100; i++ {
+			k, v := randString(64), randString(32)
+			values[k] = v
+			So(stateDB.Put("state", k, v), ShouldBeNil)
+		}
+		stateDB.Commit(tag)
+		stateDB.Flush(tag)
+		stateDB.Close()
+		config := &common.Config{
+			DB: &common.DBConfig{
+				LdbPath: "DB/",
+				Engine:  "pebble",
+			},
+			Snapshot: &common.SnapshotConfig{
+				Enable:   true,
+				FilePath: "DB/state.iost",
+			},
+		}
+		So(ToFile(config), ShouldBeNil)
+
 		os.RemoveAll("DB/StateDB/")
-		err = FromSnapshot(config)
+		So(FromFile(config), ShouldBeNil)
+		stateDB, err = db.NewMVCCDBWithStorage("DB/StateDB", kv.PebbleStorage)
+		So(err, ShouldBeNil)
+		defer stateDB.Close()
+		So(stateDB.CurrentTag(), ShouldEqual, tag)
+		for k, v := range values {
+			value, err := stateDB.Get("state", k)
+			So(err, ShouldBeNil)
+			So(value, ShouldEqual, v)
+		}
+	})
+}
+
+func TestManifest(t *testing.T) {
+	Convey("Test of Snapshot Manifest", t, func() {
+		os.RemoveAll("DB")
+		defer os.RemoveAll("DB")
+		stateDB, err := db.NewMVCCDB("DB/StateDB")
 		So(err, ShouldBeNil)
+		for i := 0; i < 100; i++ {
+			err = stateDB.Put("state", randString(64), randString(32))
+			So(err, ShouldBeNil)
+		}
+		stateDB.Commit("abc")
+		stateDB.Flush("abc")
+		stateDB.Close()
+		config := &common.Config{
+			DB: &common.DBConfig{
+				LdbPath: "DB/",
+			},
+			Snapshot: &common.SnapshotConfig{
+				FilePath: "DB/Snapshot.iost",
+			},
+		}
+		err = ToFile(config)
+		So(err, ShouldBeNil)
+
+		oldChunkSize := chunkSize
+		chunkSize = 1000
+		defer func() { chunkSize = oldChunkSize }()
+		data, err := buildManifest(config.Snapshot.FilePath)
+		So(err, ShouldBeNil)
+		manifest, err := decodeManifest(data)
+		So(err, ShouldBeNil)
+		So(string(manifest.BlockHash), ShouldEqual, "abc")
+
+		file, err := os.ReadFile(config.Snapshot.FilePath)
+		So(err, ShouldBeNil)
+		So(manifest.Size, ShouldEqual, len(file))
+		So(len(manifest.ChunkHashes), ShouldBeGreaterThan, 1)
+		for i := range manifest.ChunkHashes {
+			chunk := file[int64(i)*chunkSize : min(int64(i+1)*chunkSize, int64(len(file)))]
+			So(verifyChunk(manifest, int64(i), chunk), ShouldBeNil)
+		}
+
+		chunk := append([]byte{}, file[:chunkSize]...)
+		chunk[0] ^= 0xff
+		So(verifyChunk(manifest, 0, chunk), ShouldNotBeNil)
+		So(verifyChunk(manifest, 0, file[:chunkSize-1]), ShouldNotBeNil)
+		So(verifyChunk(manifest, int64(len(manifest.ChunkHashes)), file[:chunkSize]), ShouldNotBeNil)
+
+		_, err = decodeManifest(data[:len(data)-1])
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestFastSync(t *testing.T) {
+	Convey("Test of Snapshot Fast Sync", t, func() {
+		os.RemoveAll("DB")
+		defer os.RemoveAll("DB")
+
+		Convey("refuse to sync without trusted hash", func() {
+			config := &common.Config{
+				DB:       &common.DBConfig{LdbPath: "DB/"},
+				Snapshot: &common.SnapshotConfig{Sync: true, FilePath: "DB/Snapshot.iost"},
+			}
+			So(FastSync(config), ShouldEqual, errNoTrustedHash)
+		})
+
+		Convey("stop downloading if no peer responds", func() {
+			oldTimeout, oldInterval := chunkRequestTimeout, chunkCheckInterval
+			chunkRequestTimeout, chunkCheckInterval = 10*time.Millisecond, 5*time.Millisecond
+			defer func() { chunkRequestTimeout, chunkCheckInterval = oldTimeout, oldInterval }()
+			So(os.MkdirAll("DB", 0755), ShouldBeNil)
+
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			p := p2p_mock.NewMockService(ctl)
+			p.EXPECT().SendToPeer(gomock.Any(), gomock.Any(), p2p.SnapshotChunkRequest, gomock.Any()).AnyTimes()
+			p.EXPECT().ReportPeer(gomock.Any(), p2p.SyncTimeout).MinTimes(2 * maxPeerFailures)
+
+			f := &fastSync{
+				conf:  &common.Config{Snapshot: &common.SnapshotConfig{FilePath: "DB/Snapshot.iost"}},
+				p:     p,
+				msgCh: make(chan p2p.IncomingMessage),
+			}
+			o := &offer{
+				manifest: &snapshotpb.Manifest{ChunkSize: 1, ChunkHashes: [][]byte{{1}}},
+				hash:     []byte("manifest"),
+				peers:    []p2p.PeerID{"peerA", "peerB"},
+			}
+			So(f.download(o), ShouldEqual, errNoSnapshotPeer)
+		})
 	})
 }
 
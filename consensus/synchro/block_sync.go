@@ -45,6 +45,12 @@ func newBlockSync(p p2p.Service) *blockSync {
 		quitCh: make(chan struct{}),
 		done:   new(sync.WaitGroup),
 	}
+	// The request is cached with the requested peer until it is answered, so the expired one is a timeout.
+	b.requestCache.OnEvicted(func(_ string, v any) {
+		if peerID, ok := v.(p2p.PeerID); ok && peerID != "" {
+			b.p.ReportPeer(peerID, p2p.SyncTimeout)
+		}
+	})
 
 	b.done.Add(1)
 	go b.controller()
@@ -70,7 +76,7 @@ func (b *blockSync) RequestBlock(hash []byte, peerID p2p.PeerID, mtype p2p.Messa
 		ilog.Debugf("Discard the duplicate request block %v", common.Base58Encode(hash))
 		return
 	}
-	b.requestCache.Set(string(hash), "", cache.DefaultExpiration)
+	b.requestCache.Set(string(hash), peerID, cache.DefaultExpiration)
 
 	// Historical issues cause number to be useless.
 	blockInfo := &msgpb.BlockInfo{
@@ -97,8 +103,17 @@ func (b *blockSync) handleBlock(msg *p2p.IncomingMessage) {
 	err := blk.Decode(msg.Data())
 	if err != nil {
 		ilog.Warnf("Decode block failed: %v", err)
+		b.p.ReportPeer(msg.From(), p2p.MalformedMessage)
 		return
 	}
+	b.receiveBlock(blk, msg.From())
+}
+
+// receiveBlock removes the duplicate block and passes it to the incoming block channel.
+func (b *blockSync) receiveBlock(blk *block.Block, from p2p.PeerID) {
+	if _, found := b.requestCache.Get(string(blk.HeadHash())); found {
+		b.requestCache.Set(string(blk.HeadHash()), p2p.PeerID(""), cache.DefaultExpiration)
+	}
 
 	// Discard the most recently received duplicate block by hash
 	_, found := b.responseCache.Get(string(blk.HeadHash()))
@@ -106,13 +121,28 @@ func (b *blockSync) handleBlock(msg *p2p.IncomingMessage) {
 		ilog.Debugf("Discard the duplicate received block %v", common.Base58Encode(blk.HeadHash()))
 		return
 	}
-	b.responseCache.Set(string(blk.HeadHash()), "", cache.DefaultExpiration)
+	b.responseCache.Set(string(blk.HeadHash()), from, cache.DefaultExpiration)
 
-	ilog.Debugf("Received block %v from peer %v, num: %v", common.Base58Encode(blk.HeadHash()), msg.From().String(), blk.Head.Number)
+	ilog.Debugf("Received block %v from peer %v, num: %v", common.Base58Encode(blk.HeadHash()), from.String(), blk.Head.Number)
 
 	b.blockCh <- blk
 }
 
+// forgetBlock removes the received block, so that it isn't discarded as duplicate when it is received again.
+func (b *blockSync) forgetBlock(hash []byte) {
+	b.responseCache.Delete(string(hash))
+}
+
+// BlockSender returns the peer which sent the block recently.
+func (b *blockSync) BlockSender(hash []byte) (p2p.PeerID, bool) {
+	v, found := b.responseCache.Get(string(hash))
+	if !found {
+		return "", false
+	}
+	peerID, ok := v.(p2p.PeerID)
+	return peerID, ok
+}
+
 func (b *blockSync) controller() {
 	for {
 		select {
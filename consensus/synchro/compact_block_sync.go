@@ -0,0 +1,211 @@
+package synchro
+
+import (
+	"bytes"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/iost-official/go-iost/v3/chainbase"
+	"github.com/iost-official/go-iost/v3/common"
+	msgpb "github.com/iost-official/go-iost/v3/consensus/synchro/pb"
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/core/tx"
+	"github.com/iost-official/go-iost/v3/ilog"
+	"github.com/iost-official/go-iost/v3/p2p"
+	"github.com/patrickmn/go-cache"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	partialBlockCacheExpiration     = 3 * time.Second
+	partialBlockCachePurgeInterval  = 1 * time.Second
+	reconstructedCacheExpiration    = 10 * time.Second
+	reconstructedCachePurgeInterval = 1 * time.Minute
+)
+
+// partialBlock is the reconstructed block waiting for its missing txs.
+type partialBlock struct {
+	blk     *block.Block
+	missing []int32
+	from    p2p.PeerID
+	// done is set when the missing txs are received, otherwise the full block is requested when it expires
+	done atomic.Bool
+}
+
+// compactBlockSync is responsible for reconstructing the compact blocks from neighbors with the txs in txpool.
+// The missing txs are requested from the sender, and the full block is requested if the reconstruction is wrong,
+// the missing txs aren't received in time, or the reconstructed block fails in verification.
+type compactBlockSync struct {
+	cBase        *chainbase.ChainBase
+	p            p2p.Service
+	blockSync    *blockSync
+	partialCache *cache.Cache
+	// reconstructed keeps the senders of the reconstructed blocks
+	reconstructed *cache.Cache
+
+	msgCh chan p2p.IncomingMessage
+
+	quitCh chan struct{}
+	done   *sync.WaitGroup
+}
+
+func newCompactBlockSync(cBase *chainbase.ChainBase, p p2p.Service, blockSync *blockSync) *compactBlockSync {
+	c := &compactBlockSync{
+		cBase:         cBase,
+		p:             p,
+		blockSync:     blockSync,
+		partialCache:  cache.New(partialBlockCacheExpiration, partialBlockCachePurgeInterval),
+		reconstructed: cache.New(reconstructedCacheExpiration, reconstructedCachePurgeInterval),
+
+		msgCh: p.Register("compact block from other nodes", p2p.NewCompactBlock, p2p.BlockTxResponse),
+
+		quitCh: make(chan struct{}),
+		done:   new(sync.WaitGroup),
+	}
+
+	c.partialCache.OnEvicted(func(hash string, v any) {
+		if pb := v.(*partialBlock); !pb.done.Load() {
+			ilog.Debugf("Missing txs of compact block %v timed out, request the full block", common.Base58Encode([]byte(hash)))
+			c.blockSync.RequestBlock([]byte(hash), pb.from, p2p.NewBlockRequest)
+		}
+	})
+
+	c.done.Add(1)
+	go c.controller()
+
+	return c
+}
+
+func (c *compactBlockSync) Close() {
+	close(c.quitCh)
+	c.done.Wait()
+	ilog.Infof("Stopped compact block sync.")
+}
+
+func (c *compactBlockSync) handleCompactBlock(msg *p2p.IncomingMessage) {
+	cb := &block.CompactBlock{}
+	if err := cb.Decode(msg.Data()); err != nil {
+		ilog.Warnf("Decode compact block failed: %v", err)
+		c.p.ReportPeer(msg.From(), p2p.MalformedMessage)
+		return
+	}
+	hash := cb.HeadHash()
+	if _, found := c.partialCache.Get(string(hash)); found {
+		return
+	}
+	if _, found := c.blockSync.BlockSender(hash); found {
+		ilog.Debugf("Discard the duplicate received compact block %v", common.Base58Encode(hash))
+		return
+	}
+	if _, ok := c.cBase.GetBlockByHash(hash); ok {
+		return
+	}
+
+	pending, _ := c.cBase.TxPool().PendingTx()
+	blk, missing := cb.Reconstruct(pending.GetByShortID)
+	if len(missing) == 0 {
+		c.completeBlock(blk, msg.From())
+		return
+	}
+	c.partialCache.Set(string(hash), &partialBlock{blk: blk, missing: missing, from: msg.From()}, cache.DefaultExpiration)
+
+	req, err := proto.Marshal(&msgpb.BlockTxRequest{Hash: hash, Indexes: missing})
+	if err != nil {
+		ilog.Errorf("Marshal block tx request failed: %v", err)
+		return
+	}
+	ilog.Debugf("Request %v/%v missing txs of compact block %v from peer %v", len(missing), len(blk.Txs), common.Base58Encode(hash), msg.From().String())
+	c.p.SendToPeer(msg.From(), req, p2p.BlockTxRequest, p2p.UrgentMessage)
+}
+
+func (c *compactBlockSync) handleBlockTxResponse(msg *p2p.IncomingMessage) {
+	resp := &msgpb.BlockTxResponse{}
+	if err := proto.Unmarshal(msg.Data(), resp); err != nil {
+		ilog.Warnf("Unmarshal block tx response failed: %v", err)
+		c.p.ReportPeer(msg.From(), p2p.MalformedMessage)
+		return
+	}
+	v, found := c.partialCache.Get(string(resp.Hash))
+	if !found {
+		return
+	}
+	pb := v.(*partialBlock)
+	if pb.from != msg.From() {
+		return
+	}
+	pb.done.Store(true)
+	c.partialCache.Delete(string(resp.Hash))
+
+	if len(resp.Txs) == 0 {
+		// the sender doesn't have the block yet
+		c.blockSync.RequestBlock(resp.Hash, msg.From(), p2p.NewBlockRequest)
+		return
+	}
+	if len(resp.Txs) != len(pb.missing) {
+		ilog.Warnf("Block tx response has %v txs, expect %v", len(resp.Txs), len(pb.missing))
+		c.p.ReportPeer(msg.From(), p2p.MalformedMessage)
+		c.blockSync.RequestBlock(resp.Hash, msg.From(), p2p.NewBlockRequest)
+		return
+	}
+	for i, idx := range pb.missing {
+		t := &tx.Tx{}
+		if err := t.Decode(resp.Txs[i]); err != nil {
+			ilog.Warnf("Decode tx failed: %v", err)
+			c.p.ReportPeer(msg.From(), p2p.MalformedMessage)
+			c.blockSync.RequestBlock(resp.Hash, msg.From(), p2p.NewBlockRequest)
+			return
+		}
+		pb.blk.Txs[idx] = t
+	}
+	c.completeBlock(pb.blk, msg.From())
+}
+
+// completeBlock passes the reconstructed block to block sync, or requests the full block if the short ids collide.
+func (c *compactBlockSync) completeBlock(blk *block.Block, from p2p.PeerID) {
+	if !bytes.Equal(blk.CalculateTxMerkleHash(), blk.Head.TxMerkleHash) {
+		ilog.Debugf("Reconstruct compact block %v failed, request the full block", common.Base58Encode(blk.HeadHash()))
+		c.blockSync.RequestBlock(blk.HeadHash(), from, p2p.NewBlockRequest)
+		return
+	}
+	c.reconstructed.Set(string(blk.HeadHash()), from, cache.DefaultExpiration)
+	c.blockSync.receiveBlock(blk, from)
+}
+
+// isPending returns whether the compact block is waiting for its missing txs.
+func (c *compactBlockSync) isPending(hash []byte) bool {
+	_, found := c.partialCache.Get(string(hash))
+	return found
+}
+
+// requestFullBlock requests the full block from the sender if the block is reconstructed from a compact block,
+// and returns whether it is requested.
+func (c *compactBlockSync) requestFullBlock(hash []byte) bool {
+	v, found := c.reconstructed.Get(string(hash))
+	if !found {
+		return false
+	}
+	c.reconstructed.Delete(string(hash))
+	c.blockSync.forgetBlock(hash)
+	c.blockSync.RequestBlock(hash, v.(p2p.PeerID), p2p.NewBlockRequest)
+	return true
+}
+
+func (c *compactBlockSync) controller() {
+	for {
+		select {
+		case msg := <-c.msgCh:
+			switch msg.Type() {
+			case p2p.NewCompactBlock:
+				c.handleCompactBlock(&msg)
+			case p2p.BlockTxResponse:
+				c.handleBlockTxResponse(&msg)
+			default:
+				ilog.Warnf("Unexcept compact block message type: %v", msg.Type())
+			}
+		case <-c.quitCh:
+			c.done.Done()
+			return
+		}
+	}
+}
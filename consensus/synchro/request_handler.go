@@ -33,7 +33,7 @@ func newRequestHandler(cBase *chainbase.ChainBase, p p2p.Service) *requestHandle
 	rHandler := &requestHandler{
 		pool: tunny.NewFunc(workerPoolSize, worker.process),
 
-		requestCh: p.Register("sync request", p2p.SyncBlockHashRequest, p2p.SyncBlockRequest, p2p.NewBlockRequest),
+		requestCh: p.Register("sync request", p2p.SyncBlockHashRequest, p2p.SyncBlockRequest, p2p.NewBlockRequest, p2p.BlockTxRequest),
 
 		quitCh: make(chan struct{}),
 		done:   new(sync.WaitGroup),
@@ -175,6 +175,39 @@ func (r *requestHandlerWorker) handleBlockRequest(request *p2p.IncomingMessage,
 	r.p.SendToPeer(request.From(), msg, mtype, priority)
 }
 
+func (r *requestHandlerWorker) handleBlockTxRequest(request *p2p.IncomingMessage) {
+	blockTxRequest := &msgpb.BlockTxRequest{}
+	if err := proto.Unmarshal(request.Data(), blockTxRequest); err != nil {
+		ilog.Warnf("Unmarshal BlockTxRequest failed: %v", err)
+		return
+	}
+
+	blockTxResponse := &msgpb.BlockTxResponse{
+		Hash: blockTxRequest.Hash,
+		Txs:  make([][]byte, 0, len(blockTxRequest.Indexes)),
+	}
+	block, ok := r.cBase.GetBlockByHash(blockTxRequest.Hash)
+	if !ok {
+		// the empty response makes the requester request the full block at once
+		ilog.Warnf("Handle block tx request failed, from=%v, hash=%v.", request.From().String(), common.Base58Encode(blockTxRequest.Hash))
+		blockTxRequest.Indexes = nil
+	}
+	for _, idx := range blockTxRequest.Indexes {
+		if idx < 0 || int(idx) >= len(block.Txs) {
+			ilog.Warnf("Receive attack request from peer %v, tx index: %v.", request.From().String(), idx)
+			return
+		}
+		blockTxResponse.Txs = append(blockTxResponse.Txs, block.Txs[idx].Encode())
+	}
+
+	msg, err := proto.Marshal(blockTxResponse)
+	if err != nil {
+		ilog.Errorf("Marshal BlockTxResponse failed: %v", err)
+		return
+	}
+	r.p.SendToPeer(request.From(), msg, p2p.BlockTxResponse, p2p.UrgentMessage)
+}
+
 func (r *requestHandlerWorker) process(payload any) any {
 	request, ok := payload.(*p2p.IncomingMessage)
 	if !ok {
@@ -189,6 +222,8 @@ func (r *requestHandlerWorker) process(payload any) any {
 		r.handleBlockRequest(request, p2p.SyncBlockResponse, p2p.NormalMessage)
 	case p2p.NewBlockRequest:
 		r.handleBlockRequest(request, p2p.NewBlock, p2p.UrgentMessage)
+	case p2p.BlockTxRequest:
+		r.handleBlockTxRequest(request)
 	default:
 		ilog.Warnf("Unexcept request type: %v", request.Type())
 	}
@@ -19,7 +19,7 @@ const (
 )
 
 // Sync is the synchronizer of blockchain.
-// It includes requestHandler, heightSync, blockhashSync, blockSync.
+// It includes requestHandler, heightSync, blockhashSync, blockSync, compactBlockSync.
 type Sync struct {
 	cBase   *chainbase.ChainBase
 	p       p2p.Service
@@ -31,6 +31,8 @@ type Sync struct {
 	blockhashSync   *blockHashSync
 	blockSync       *blockSync
 
+	compactBlockSync *compactBlockSync
+
 	quitCh chan struct{}
 	done   *sync.WaitGroup
 }
@@ -51,6 +53,7 @@ func New(cBase *chainbase.ChainBase, p p2p.Service) *Sync {
 		quitCh: make(chan struct{}),
 		done:   new(sync.WaitGroup),
 	}
+	sync.compactBlockSync = newCompactBlockSync(cBase, p, sync.blockSync)
 
 	sync.done.Add(6)
 	go sync.syncHeightController()
@@ -72,6 +75,7 @@ func (s *Sync) Close() {
 	s.rangeController.Close()
 	s.heightSync.Close()
 	s.blockhashSync.Close()
+	s.compactBlockSync.Close()
 	s.blockSync.Close()
 
 	ilog.Infof("Stopped sync.")
@@ -113,6 +117,17 @@ func (s *Sync) BroadcastBlock(block *block.Block) {
 	s.p.Broadcast(msg, p2p.NewBlock, p2p.UrgentMessage)
 }
 
+// BroadcastCompactBlock will broadcast new block to neighbor nodes as compact block,
+// which are reconstructed with the txs in their txpool.
+func (s *Sync) BroadcastCompactBlock(blk *block.Block) {
+	msg, err := block.NewCompactBlock(blk).Encode()
+	if err != nil {
+		ilog.Errorf("Encode compact block failed: %v", err)
+		return
+	}
+	s.p.Broadcast(msg, p2p.NewCompactBlock, p2p.UrgentMessage)
+}
+
 func (s *Sync) doHeightSync() {
 	syncHeight := &msgpb.SyncHeight{
 		Height: s.cBase.HeadBlock().Head.Number,
@@ -215,6 +230,10 @@ func (s *Sync) doNewBlockSync(blockHash *BlockHash) {
 		ilog.Debugf("New block hash %v already exists.", common.Base58Encode(blockHash.Hash))
 		return
 	}
+	if _, found := s.blockSync.BlockSender(blockHash.Hash); found || s.compactBlockSync.isPending(blockHash.Hash) {
+		ilog.Debugf("New block hash %v is received or being reconstructed.", common.Base58Encode(blockHash.Hash))
+		return
+	}
 
 	// New block hash just have 0 number peer ID.
 	s.blockSync.RequestBlock(blockHash.Hash, blockHash.PeerID[0], p2p.NewBlockRequest)
@@ -232,6 +251,26 @@ func (s *Sync) handleNewBlockHashController() {
 	}
 }
 
+// RequestFullBlock requests the full block from the sender if the block is reconstructed from a compact block,
+// and returns whether it is requested. The receipts of a reconstructed block are computed locally, so it may fail
+// in verification though it is valid, for example, some txs timed out in generation.
+func (s *Sync) RequestFullBlock(block *block.Block) bool {
+	return s.compactBlockSync.requestFullBlock(block.HeadHash())
+}
+
+// ReportBlock reports the peer which sent the block by whether the block is valid.
+func (s *Sync) ReportBlock(block *block.Block, valid bool) {
+	peerID, ok := s.blockSync.BlockSender(block.HeadHash())
+	if !ok {
+		return
+	}
+	if valid {
+		s.p.ReportPeer(peerID, p2p.UsefulResponse)
+	} else {
+		s.p.ReportPeer(peerID, p2p.InvalidBlock)
+	}
+}
+
 func (s *Sync) doBlockFilter(block *block.Block) {
 	head := s.cBase.HeadBlock().Head.Number
 	lib := s.cBase.LIBlock().Head.Number
@@ -329,6 +329,7 @@ func (p *peer) NewSync(ctrl *gomock.Controller) {
 	p2pService.EXPECT().Register(gomock.Any(), gomock.Any()).DoAndReturn(p.p2pRegister).AnyTimes()
 	p2pService.EXPECT().Broadcast(gomock.Any(), gomock.Any(), gomock.Any()).Do(p.p2pBroadcast).AnyTimes()
 	p2pService.EXPECT().SendToPeer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Do(p.p2pSendToPeer).AnyTimes()
+	p2pService.EXPECT().ReportPeer(gomock.Any(), gomock.Any()).AnyTimes()
 
 	bCache := mock.NewMockBlockCache(ctrl)
 	bCache.EXPECT().Head().DoAndReturn(p.bcacheHead).AnyTimes()
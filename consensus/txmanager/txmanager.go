@@ -1,22 +1,53 @@
 package txmanager
 
 import (
+	"fmt"
+	"slices"
 	"sync"
 	"time"
 
 	"github.com/Jeffail/tunny"
+	msgpb "github.com/iost-official/go-iost/v3/consensus/synchro/pb"
 	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/core/txpool"
 	"github.com/iost-official/go-iost/v3/ilog"
 	"github.com/iost-official/go-iost/v3/p2p"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/patrickmn/go-cache"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
 	txHandlerPoolSize = 2
 	timeout           = 2 * time.Second
+
+	announceInterval     = 100 * time.Millisecond
+	maxAnnounceHashes    = 1000
+	requestExpiration    = 5 * time.Second
+	requestPurgeInterval = 1 * time.Second
+	maxTxResponseSize    = 1024 * 1024
+
+	announcerExpiration    = time.Minute
+	announcerPurgeInterval = 10 * time.Second
 )
 
+// txRequest is a requested tx, it is requested from the next announcer if the current one doesn't respond in time.
+type txRequest struct {
+	from       p2p.PeerID
+	announcers []p2p.PeerID
+	received   bool
+}
+
+func (r *txRequest) addAnnouncer(pid p2p.PeerID) {
+	if pid == r.from || slices.Contains(r.announcers, pid) {
+		return
+	}
+	r.announcers = append(r.announcers, pid)
+}
+
 // TxManager will maintain the tx received from p2p.
+// The new txs are announced to neighbors by batched hashes, and neighbors only request the ones they don't have.
+// The neighbors which haven't announced txs recently may not support the announcement, the full txs are sent to them.
 type TxManager struct {
 	p      p2p.Service
 	txPool txpool.TxPool
@@ -24,6 +55,13 @@ type TxManager struct {
 
 	msgCh chan p2p.IncomingMessage
 
+	announceMutex sync.Mutex
+	announceTxs   []*tx.Tx
+	requestMutex  sync.Mutex
+	requestCache  *cache.Cache
+	// announcers keeps the neighbors which support the announcement
+	announcers *cache.Cache
+
 	quitCh chan struct{}
 	done   *sync.WaitGroup
 }
@@ -34,15 +72,20 @@ func New(p p2p.Service, txPool txpool.TxPool) *TxManager {
 		p:      p,
 		txPool: txPool,
 
-		msgCh: p.Register("tx from other nodes", p2p.PublishTx),
+		msgCh: p.Register("tx from other nodes", p2p.PublishTx, p2p.NewTxHash, p2p.TxRequest, p2p.TxResponse),
+
+		requestCache: cache.New(requestExpiration, requestPurgeInterval),
+		announcers:   cache.New(announcerExpiration, announcerPurgeInterval),
 
 		quitCh: make(chan struct{}),
 		done:   new(sync.WaitGroup),
 	}
-	t.pool = tunny.NewFunc(txHandlerPoolSize, t.handleTx)
+	t.pool = tunny.NewFunc(txHandlerPoolSize, t.handleMessage)
+	t.requestCache.OnEvicted(t.onRequestEvicted)
 
-	t.done.Add(1)
+	t.done.Add(2)
 	go t.receiveP2PTxController()
+	go t.announceController()
 
 	return t
 }
@@ -54,25 +97,253 @@ func (t *TxManager) Close() {
 	ilog.Infof("Stopped tx manager.")
 }
 
-func (t *TxManager) handleTx(payload any) any {
+func (t *TxManager) handleMessage(payload any) any {
 	msg, ok := payload.(*p2p.IncomingMessage)
 	if !ok {
 		ilog.Warnf("Assert payload to IncomingMessage failed")
 		return nil
 	}
 
+	if msg.Type() != p2p.PublishTx {
+		t.announcers.SetDefault(msg.From().String(), true)
+	}
+
+	switch msg.Type() {
+	case p2p.PublishTx:
+		t.handleTx(msg)
+	case p2p.NewTxHash:
+		t.handleNewTxHash(msg)
+	case p2p.TxRequest:
+		t.handleTxRequest(msg)
+	case p2p.TxResponse:
+		t.handleTxResponse(msg)
+	default:
+		ilog.Warnf("Unexcept message type: %v", msg.Type())
+	}
+	return nil
+}
+
+func (t *TxManager) handleTx(msg *p2p.IncomingMessage) {
 	transaction := &tx.Tx{}
 	err := transaction.Decode(msg.Data())
 	if err != nil {
 		ilog.Errorf("decode tx error. err=%v", err)
-		return nil
+		t.p.ReportPeer(msg.From(), p2p.MalformedMessage)
+		return
+	}
+	t.requestMutex.Lock()
+	r, requested := t.requestCache.Get(string(transaction.Hash()))
+	if requested {
+		r.(*txRequest).received = true
+	}
+	t.requestMutex.Unlock()
+	if requested {
+		t.requestCache.Delete(string(transaction.Hash()))
+	}
+	t.addTx(transaction)
+}
+
+// handleTxResponse adds the txs requested from the peer, the others in the response are ignored.
+func (t *TxManager) handleTxResponse(msg *p2p.IncomingMessage) {
+	resp := &msgpb.TxResponse{}
+	err := proto.Unmarshal(msg.Data(), resp)
+	if err == nil && len(resp.Txs) > maxAnnounceHashes {
+		err = fmt.Errorf("too many txs: %v", len(resp.Txs))
+	}
+	if err != nil {
+		ilog.Warnf("Decode tx response from %v failed: %v", msg.From().String(), err)
+		t.p.ReportPeer(msg.From(), p2p.MalformedMessage)
+		return
+	}
+
+	for _, data := range resp.Txs {
+		transaction := &tx.Tx{}
+		if err := transaction.Decode(data); err != nil {
+			ilog.Errorf("decode tx error. err=%v", err)
+			t.p.ReportPeer(msg.From(), p2p.MalformedMessage)
+			return
+		}
+		t.requestMutex.Lock()
+		r, requested := t.requestCache.Get(string(transaction.Hash()))
+		requested = requested && r.(*txRequest).from == msg.From()
+		if requested {
+			r.(*txRequest).received = true
+		}
+		t.requestMutex.Unlock()
+		if !requested {
+			continue
+		}
+		t.requestCache.Delete(string(transaction.Hash()))
+		t.addTx(transaction)
 	}
+}
+
+func (t *TxManager) addTx(transaction *tx.Tx) {
 	if err := t.txPool.AddTx(transaction, "p2p"); err != nil {
 		ilog.Debugf("Add tx failed: %v", err)
-		return nil
+		return
+	}
+	t.announce(transaction)
+}
+
+// handleNewTxHash requests the announced txs which are neither in txpool nor requested from other peers.
+// The peer is kept as an announcer of the txs requested from others, which is requested if they don't respond.
+func (t *TxManager) handleNewTxHash(msg *p2p.IncomingMessage) {
+	hashes, err := t.decodeTxHashes(msg)
+	if err != nil {
+		return
+	}
+
+	missing := make([][]byte, 0, len(hashes.Hashes))
+	t.requestMutex.Lock()
+	for _, hash := range hashes.Hashes {
+		if t.hasTx(hash) {
+			continue
+		}
+		if r, ok := t.requestCache.Get(string(hash)); ok {
+			r.(*txRequest).addAnnouncer(msg.From())
+			continue
+		}
+		t.requestCache.Set(string(hash), &txRequest{from: msg.From()}, cache.DefaultExpiration)
+		missing = append(missing, hash)
+	}
+	t.requestMutex.Unlock()
+	t.requestTxs(msg.From(), missing)
+}
+
+// onRequestEvicted requests the tx from the next announcer when the request expires.
+func (t *TxManager) onRequestEvicted(hash string, value any) {
+	t.requestMutex.Lock()
+	r := value.(*txRequest)
+	if r.received || len(r.announcers) == 0 || t.hasTx([]byte(hash)) {
+		t.requestMutex.Unlock()
+		return
+	}
+	from := r.announcers[0]
+	t.requestCache.Set(hash, &txRequest{from: from, announcers: r.announcers[1:]}, cache.DefaultExpiration)
+	t.requestMutex.Unlock()
+	t.requestTxs(from, [][]byte{[]byte(hash)})
+}
+
+func (t *TxManager) requestTxs(from p2p.PeerID, hashes [][]byte) {
+	if len(hashes) == 0 {
+		return
+	}
+	req, err := proto.Marshal(&msgpb.TxHashes{Hashes: hashes})
+	if err != nil {
+		ilog.Errorf("Marshal tx hashes failed: %v", err)
+		return
+	}
+	t.p.SendToPeer(from, req, p2p.TxRequest, p2p.NormalMessage)
+}
+
+// handleTxRequest sends the requested txs in the pending list back to the peer by batches.
+func (t *TxManager) handleTxRequest(msg *p2p.IncomingMessage) {
+	hashes, err := t.decodeTxHashes(msg)
+	if err != nil {
+		return
+	}
+
+	resp := &msgpb.TxResponse{}
+	size := 0
+	for _, hash := range hashes.Hashes {
+		transaction, err := t.txPool.GetFromPending(hash)
+		if err != nil {
+			continue
+		}
+		data := transaction.Encode()
+		if size+len(data) > maxTxResponseSize && len(resp.Txs) > 0 {
+			t.sendTxResponse(msg.From(), resp)
+			resp, size = &msgpb.TxResponse{}, 0
+		}
+		resp.Txs = append(resp.Txs, data)
+		size += len(data)
+	}
+	if len(resp.Txs) > 0 {
+		t.sendTxResponse(msg.From(), resp)
+	}
+}
+
+func (t *TxManager) sendTxResponse(to p2p.PeerID, resp *msgpb.TxResponse) {
+	data, err := proto.Marshal(resp)
+	if err != nil {
+		ilog.Errorf("Marshal tx response failed: %v", err)
+		return
+	}
+	t.p.SendToPeer(to, data, p2p.TxResponse, p2p.NormalMessage)
+}
+
+func (t *TxManager) decodeTxHashes(msg *p2p.IncomingMessage) (*msgpb.TxHashes, error) {
+	hashes := &msgpb.TxHashes{}
+	err := proto.Unmarshal(msg.Data(), hashes)
+	if err == nil && len(hashes.Hashes) > maxAnnounceHashes {
+		err = fmt.Errorf("too many hashes: %v", len(hashes.Hashes))
+	}
+	if err != nil {
+		ilog.Warnf("Decode tx hashes from %v failed: %v", msg.From().String(), err)
+		t.p.ReportPeer(msg.From(), p2p.MalformedMessage)
+		return nil, err
+	}
+	return hashes, nil
+}
+
+func (t *TxManager) hasTx(hash []byte) bool {
+	if _, err := t.txPool.GetFromPending(hash); err == nil {
+		return true
+	}
+	_, _, err := t.txPool.GetFromChain(hash)
+	return err == nil
+}
+
+// announce adds the tx into the next batch of announcement.
+func (t *TxManager) announce(transaction *tx.Tx) {
+	t.announceMutex.Lock()
+	t.announceTxs = append(t.announceTxs, transaction)
+	full := len(t.announceTxs) >= maxAnnounceHashes
+	t.announceMutex.Unlock()
+
+	if full {
+		t.broadcastTxHashes()
+	}
+}
+
+func (t *TxManager) broadcastTxHashes() {
+	t.announceMutex.Lock()
+	txs := t.announceTxs
+	t.announceTxs = nil
+	t.announceMutex.Unlock()
+	if len(txs) == 0 {
+		return
+	}
+
+	// The full txs are sent to the neighbors which may not support the announcement.
+	for _, neighbor := range t.p.GetAllNeighbors() {
+		if _, found := t.announcers.Get(neighbor.ID()); found {
+			continue
+		}
+		pid, err := peer.Decode(neighbor.ID())
+		if err != nil {
+			continue
+		}
+		for _, transaction := range txs {
+			t.p.SendToPeer(pid, transaction.Encode(), p2p.PublishTx, p2p.NormalMessage)
+		}
+	}
+
+	hashes := make([][]byte, 0, len(txs))
+	for _, transaction := range txs {
+		hashes = append(hashes, transaction.Hash())
+	}
+	for len(hashes) > 0 {
+		n := min(len(hashes), maxAnnounceHashes)
+		msg, err := proto.Marshal(&msgpb.TxHashes{Hashes: hashes[:n]})
+		if err != nil {
+			ilog.Errorf("Marshal tx hashes failed: %v", err)
+			return
+		}
+		t.p.Broadcast(msg, p2p.NewTxHash, p2p.NormalMessage)
+		hashes = hashes[n:]
 	}
-	t.p.Broadcast(transaction.Encode(), p2p.PublishTx, p2p.NormalMessage)
-	return nil
 }
 
 func (t *TxManager) handle(msg *p2p.IncomingMessage) {
@@ -93,3 +364,17 @@ func (t *TxManager) receiveP2PTxController() {
 		}
 	}
 }
+
+func (t *TxManager) announceController() {
+	ticker := time.NewTicker(announceInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			t.broadcastTxHashes()
+		case <-t.quitCh:
+			t.done.Done()
+			return
+		}
+	}
+}
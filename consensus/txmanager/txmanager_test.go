@@ -0,0 +1,140 @@
+package txmanager
+
+import (
+	"bytes"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	msgpb "github.com/iost-official/go-iost/v3/consensus/synchro/pb"
+	"github.com/iost-official/go-iost/v3/core/tx"
+	"github.com/iost-official/go-iost/v3/core/txpool"
+	txpool_mock "github.com/iost-official/go-iost/v3/core/txpool/mock"
+	"github.com/iost-official/go-iost/v3/p2p"
+	p2p_mock "github.com/iost-official/go-iost/v3/p2p/mocks"
+)
+
+type sentMessage struct {
+	to   p2p.PeerID
+	typ  p2p.MessageType
+	msg  *msgpb.TxHashes
+	resp *msgpb.TxResponse
+}
+
+func TestAnnounceAndRequest(t *testing.T) {
+	requestExpiration, requestPurgeInterval = 50*time.Millisecond, 10*time.Millisecond
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	transaction := tx.NewTx(nil, nil, 100000, 100, 1, 0, 1024)
+	hash := transaction.Hash()
+	var received atomic.Bool
+
+	pool := txpool_mock.NewMockTxPool(ctl)
+	pool.EXPECT().GetFromPending(gomock.Any()).AnyTimes().DoAndReturn(func(h []byte) (*tx.Tx, error) {
+		if received.Load() && bytes.Equal(h, hash) {
+			return transaction, nil
+		}
+		return nil, txpool.ErrTxNotFound
+	})
+	pool.EXPECT().GetFromChain(gomock.Any()).AnyTimes().Return(nil, nil, txpool.ErrTxNotFound)
+	pool.EXPECT().AddTx(gomock.Any(), "p2p").Return(nil)
+
+	sent := make(chan sentMessage, 10)
+	p := p2p_mock.NewMockService(ctl)
+	p.EXPECT().Register(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(make(chan p2p.IncomingMessage))
+	p.EXPECT().SendToPeer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Do(
+		func(to p2p.PeerID, data []byte, typ p2p.MessageType, _ p2p.MessagePriority) {
+			m := sentMessage{to: to, typ: typ}
+			switch typ {
+			case p2p.TxRequest:
+				m.msg = &msgpb.TxHashes{}
+				assert.NoError(t, proto.Unmarshal(data, m.msg))
+			case p2p.TxResponse:
+				m.resp = &msgpb.TxResponse{}
+				assert.NoError(t, proto.Unmarshal(data, m.resp))
+			}
+			sent <- m
+		})
+	p.EXPECT().Broadcast(gomock.Any(), p2p.NewTxHash, gomock.Any()).AnyTimes()
+	p.EXPECT().GetAllNeighbors().AnyTimes()
+
+	m := New(p, pool)
+	defer m.Close()
+
+	announcement, err := proto.Marshal(&msgpb.TxHashes{Hashes: [][]byte{hash}})
+	assert.NoError(t, err)
+
+	// the tx is requested from the first announcer only
+	m.handleNewTxHash(p2p.NewIncomingMessage("peerA", announcement, p2p.NewTxHash))
+	m.handleNewTxHash(p2p.NewIncomingMessage("peerB", announcement, p2p.NewTxHash))
+	m.handleNewTxHash(p2p.NewIncomingMessage("peerA", announcement, p2p.NewTxHash))
+	s := <-sent
+	assert.Equal(t, sentMessage{to: "peerA", typ: p2p.TxRequest, msg: s.msg}, s)
+	assert.Equal(t, [][]byte{hash}, s.msg.Hashes)
+
+	// peerA doesn't respond, then the tx is requested from peerB
+	select {
+	case s = <-sent:
+		assert.Equal(t, p2p.PeerID("peerB"), s.to)
+		assert.Equal(t, [][]byte{hash}, s.msg.Hashes)
+	case <-time.After(time.Second):
+		t.Fatal("the tx isn't requested from the next announcer")
+	}
+
+	// peerB responds, and the tx isn't requested any more
+	resp, err := proto.Marshal(&msgpb.TxResponse{Txs: [][]byte{transaction.Encode()}})
+	assert.NoError(t, err)
+	received.Store(true)
+	m.handleTxResponse(p2p.NewIncomingMessage("peerB", resp, p2p.TxResponse))
+	select {
+	case s = <-sent:
+		t.Fatalf("unexpected message to %v", s.to)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	// the requested txs in the pending list are sent back in one TxResponse
+	missing := []byte("missing")
+	request, err := proto.Marshal(&msgpb.TxHashes{Hashes: [][]byte{hash, missing}})
+	assert.NoError(t, err)
+	m.handleTxRequest(p2p.NewIncomingMessage("peerC", request, p2p.TxRequest))
+	s = <-sent
+	assert.Equal(t, p2p.PeerID("peerC"), s.to)
+	assert.Equal(t, p2p.TxResponse, s.typ)
+	assert.Equal(t, [][]byte{transaction.Encode()}, s.resp.Txs)
+}
+
+func TestUnrequestedTxResponse(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	transaction := tx.NewTx(nil, nil, 100000, 100, 1, 0, 1024)
+	pool := txpool_mock.NewMockTxPool(ctl)
+	pool.EXPECT().GetFromPending(gomock.Any()).AnyTimes().Return(nil, txpool.ErrTxNotFound)
+	pool.EXPECT().GetFromChain(gomock.Any()).AnyTimes().Return(nil, nil, txpool.ErrTxNotFound)
+	pool.EXPECT().AddTx(gomock.Any(), gomock.Any()).Times(0)
+
+	p := p2p_mock.NewMockService(ctl)
+	p.EXPECT().Register(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(make(chan p2p.IncomingMessage))
+	p.EXPECT().SendToPeer(gomock.Any(), gomock.Any(), p2p.TxRequest, gomock.Any()).AnyTimes()
+	p.EXPECT().Broadcast(gomock.Any(), p2p.NewTxHash, gomock.Any()).AnyTimes()
+	p.EXPECT().GetAllNeighbors().AnyTimes()
+
+	m := New(p, pool)
+	defer m.Close()
+
+	resp, err := proto.Marshal(&msgpb.TxResponse{Txs: [][]byte{transaction.Encode()}})
+	assert.NoError(t, err)
+	// the tx isn't requested
+	m.handleTxResponse(p2p.NewIncomingMessage("peerA", resp, p2p.TxResponse))
+
+	// the tx is requested from peerA but responded by peerB
+	announcement, err := proto.Marshal(&msgpb.TxHashes{Hashes: [][]byte{transaction.Hash()}})
+	assert.NoError(t, err)
+	m.handleNewTxHash(p2p.NewIncomingMessage("peerA", announcement, p2p.NewTxHash))
+	m.handleTxResponse(p2p.NewIncomingMessage("peerB", resp, p2p.TxResponse))
+}
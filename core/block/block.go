@@ -240,14 +240,22 @@ func (b *Block) EncodeM() ([]byte, error) {
 	return brByte, nil
 }
 
-// VerifySelf verify block's signature and some base fields.
-func (b *Block) VerifySelf() error {
+// VerifySign verify block's signature.
+func (b *Block) VerifySign() error {
 	signature := b.Sign
 	signature.SetPubkey(account.DecodePubkey(b.Head.Witness))
 	hash := b.HeadHash()
 	if !signature.Verify(hash) {
 		return fmt.Errorf("The signature of block %v is wrong", common.Base58Encode(hash))
 	}
+	return nil
+}
+
+// VerifySelf verify block's signature and some base fields.
+func (b *Block) VerifySelf() error {
+	if err := b.VerifySign(); err != nil {
+		return err
+	}
 	if len(b.Txs) != len(b.Receipts) {
 		return fmt.Errorf("Tx len %v unmatch receipt len %v", len(b.Txs), len(b.Receipts))
 	}
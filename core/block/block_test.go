@@ -10,6 +10,7 @@ import (
 	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/crypto"
 	"github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestVerifyBasics(t *testing.T) {
@@ -162,3 +163,56 @@ func TestBlockSerialize(t *testing.T) {
 		})
 	})
 }
+
+func TestCompactBlock(t *testing.T) {
+	convey.Convey("Test of compact block", t, func() {
+		a1, err := account.NewKeyPair(nil, crypto.Secp256k1)
+		convey.So(err, convey.ShouldBeNil)
+		blk := Block{
+			Head: &BlockHead{
+				Number:     1,
+				ParentHash: []byte("parent"),
+				Witness:    a1.ReadablePubkey(),
+			},
+		}
+		for i := 0; i < 3; i++ {
+			t := &tx.Tx{
+				Time: int64(i + 1),
+				Actions: []*tx.Action{{
+					Contract:   "contract1",
+					ActionName: "actionname1",
+					Data:       "[]",
+				}},
+			}
+			blk.Txs = append(blk.Txs, t)
+			blk.Receipts = append(blk.Receipts, &tx.TxReceipt{TxHash: t.Hash(), Status: &tx.Status{Code: tx.Success}})
+		}
+		blk.Head.TxMerkleHash = blk.CalculateTxMerkleHash()
+		blk.Head.TxReceiptMerkleHash = blk.CalculateTxReceiptMerkleHash()
+		blk.CalculateHeadHash()
+		blk.Sign = a1.Sign(blk.HeadHash())
+
+		cbByte, err := NewCompactBlock(&blk).Encode()
+		convey.So(err, convey.ShouldBeNil)
+		cb := &CompactBlock{}
+		convey.So(cb.Decode(cbByte), convey.ShouldBeNil)
+		convey.So(cb.HeadHash(), convey.ShouldResemble, blk.HeadHash())
+		convey.So(len(cb.PrefilledTxs), convey.ShouldEqual, 1)
+
+		pool := map[uint64]*tx.Tx{blk.Txs[2].ShortID(): blk.Txs[2]}
+		blkRead, missing := cb.Reconstruct(func(id uint64) *tx.Tx { return pool[id] })
+		convey.So(missing, convey.ShouldResemble, []int32{1})
+		convey.So(blkRead.Txs[1], convey.ShouldBeNil)
+
+		blkRead.Txs[1] = blk.Txs[1]
+		convey.So(blkRead.CalculateTxMerkleHash(), convey.ShouldResemble, blk.Head.TxMerkleHash)
+		convey.So(blkRead.Receipts, convey.ShouldBeNil)
+		convey.So(blkRead.VerifySign(), convey.ShouldBeNil)
+
+		pb := NewCompactBlock(&blk).ToPb()
+		pb.Sign = nil
+		cbByte, err = proto.Marshal(pb)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(cb.Decode(cbByte), convey.ShouldNotBeNil)
+	})
+}
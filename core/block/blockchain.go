@@ -15,6 +15,7 @@ import (
 // BlockChain is the implementation of chain
 type BlockChain struct { //nolint:golint
 	blockChainDB *kv.Storage
+	storageType  kv.StorageType
 	rw           sync.RWMutex
 	length       int64
 	txTotal      int64
@@ -30,11 +31,17 @@ var (
 	txReceiptPrefix   = []byte("h") // txReceiptPrefix + tx hash -> block hash + receipt hash
 	receiptPrefix     = []byte("r") // receiptPrefix + receipt hash -> block hash + receipt hash
 	bReceiptPrefix    = []byte("b") // bReceiptPrefix + block hash + receipt hash -> receipt data
+	stateDiffPrefix   = []byte("d") // stateDiffPrefix + block hash + tx hash -> state diff of the tx, only saved if it is recorded
 )
 
 // NewBlockChain returns a Chain instance
 func NewBlockChain(path string) (Chain, error) {
-	levelDB, err := kv.NewStorage(path, kv.LevelDBStorage)
+	return NewBlockChainWithStorage(path, kv.LevelDBStorage)
+}
+
+// NewBlockChainWithStorage returns a Chain instance on the specify storage type
+func NewBlockChainWithStorage(path string, storageType kv.StorageType) (Chain, error) {
+	levelDB, err := kv.NewStorage(path, storageType)
 	if err != nil {
 		return nil, fmt.Errorf("fail to init blockchaindb, %v", err)
 	}
@@ -67,6 +74,7 @@ func NewBlockChain(path string) (Chain, error) {
 	}
 	BC := &BlockChain{
 		blockChainDB: levelDB,
+		storageType:  storageType,
 		length:       length,
 		txTotal:      txTotal,
 	}
@@ -106,7 +114,7 @@ func (bc *BlockChain) CopyBlocks(newLocation string, offset int64) error {
 	if bc.Length() == 0 {
 		return errors.New("no block in blockChaindb")
 	}
-	newChain, err := NewBlockChain(newLocation)
+	newChain, err := NewBlockChainWithStorage(newLocation, bc.storageType)
 	if err != nil {
 		return fmt.Errorf("fail to init blockchaindb, %v", err)
 	}
@@ -409,6 +417,54 @@ func (bc *BlockChain) GetReceiptByTxHash(hash []byte) (*tx.TxReceipt, error) {
 	return &re, nil
 }
 
+// PutStateDiffs records the state diffs of txs executed in the block, keyed by tx hash
+func (bc *BlockChain) PutStateDiffs(blockHash []byte, diffs map[string][]*tx.StateChange) error {
+	encoded := make(map[string][]byte, len(diffs))
+	for tHash, diff := range diffs {
+		data, err := tx.EncodeStateDiff(diff)
+		if err != nil {
+			return fmt.Errorf("fail to encode state diff, err:%s", err)
+		}
+		encoded[tHash] = data
+	}
+	err := bc.blockChainDB.BeginBatch()
+	if err != nil {
+		return fmt.Errorf("fail to begin batch, err:%v", err)
+	}
+	for tHash, data := range encoded {
+		bc.blockChainDB.Put(append(append(stateDiffPrefix, blockHash...), tHash...), data)
+	}
+	err = bc.blockChainDB.CommitBatch()
+	if err != nil {
+		return fmt.Errorf("fail to put state diffs, err:%s", err)
+	}
+	return nil
+}
+
+// GetStateDiffByTxHash gets the state diff recorded with tx's hash in the block on chain
+func (bc *BlockChain) GetStateDiffByTxHash(hash []byte) ([]*tx.StateChange, error) {
+	rData, err := bc.blockChainDB.Get(append(txReceiptPrefix, hash...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get the receipt: %v", err)
+	}
+	hashLen := len(rData) / 2
+	if hashLen == 0 {
+		return nil, fmt.Errorf("failed to Get the receipt: not found")
+	}
+	data, err := bc.blockChainDB.Get(append(append(stateDiffPrefix, rData[:hashLen]...), hash...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get the state diff: %v", err)
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("failed to Get the state diff: not recorded")
+	}
+	diff, err := tx.DecodeStateDiff(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Decode the state diff: %v", err)
+	}
+	return diff, nil
+}
+
 // HasReceipt checks if database has receipt.
 func (bc *BlockChain) HasReceipt(hash []byte) (bool, error) {
 	return bc.blockChainDB.Has(append(receiptPrefix, hash...))
@@ -86,6 +86,44 @@ func TestChainImpl(t *testing.T) {
 	})
 }
 
+func TestStateDiff(t *testing.T) {
+	Convey("test state diff", t, func() {
+		bc, err := NewBlockChain("./BlockChainDB/")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll("./BlockChainDB/")
+		t1 := tx.NewTx(nil, nil, 9999, 1, 1, 0, 0)
+		t2 := tx.NewTx(nil, nil, 9999, 1, 2, 0, 0)
+		diff1 := []*tx.StateChange{{Contract: "token.iost", Key: "supply", OldValue: "1", NewValue: "2"}}
+		tBlock := &Block{
+			Head: &BlockHead{
+				Version:    2,
+				ParentHash: []byte("parent Hash"),
+				Info:       []byte("info "),
+				Number:     bc.Length(),
+				Time:       201222,
+			},
+			Sign:     &crypto.Signature{},
+			Txs:      []*tx.Tx{t1, t2},
+			Receipts: []*tx.TxReceipt{tx.NewTxReceipt(t1.Hash()), tx.NewTxReceipt(t2.Hash())},
+		}
+		tBlock.CalculateHeadHash()
+		So(bc.PutStateDiffs(tBlock.HeadHash(), map[string][]*tx.StateChange{string(t1.Hash()): diff1}), ShouldBeNil)
+		_, err = bc.GetStateDiffByTxHash(t1.Hash())
+		So(err, ShouldNotBeNil)
+		So(bc.Push(tBlock), ShouldBeNil)
+
+		diff, err := bc.GetStateDiffByTxHash(t1.Hash())
+		So(err, ShouldBeNil)
+		So(diff, ShouldResemble, diff1)
+		_, err = bc.GetStateDiffByTxHash(t2.Hash())
+		So(err, ShouldNotBeNil)
+
+		r, err := bc.GetReceiptByTxHash(t1.Hash())
+		So(err, ShouldBeNil)
+		So(r.StateDiff, ShouldBeNil)
+	})
+}
+
 func BenchmarkBlock(b *testing.B) {
 	a1, _ := account.NewKeyPair(nil, crypto.Secp256k1)
 	a2, _ := account.NewKeyPair(nil, crypto.Secp256k1)
@@ -0,0 +1,123 @@
+package block
+
+import (
+	"errors"
+
+	blockpb "github.com/iost-official/go-iost/v3/core/block/pb"
+	"github.com/iost-official/go-iost/v3/core/tx"
+	txpb "github.com/iost-official/go-iost/v3/core/tx/pb"
+	"github.com/iost-official/go-iost/v3/crypto"
+	"google.golang.org/protobuf/proto"
+)
+
+// CompactBlock is the block whose txs are replaced by their short ids, except the prefilled ones, and whose receipts
+// are left out. The receiver reconstructs the block with the txs in its txpool, and requests the missing ones. The
+// receipts are computed in verification and checked by the receipt merkle hash in head.
+type CompactBlock struct {
+	hash         []byte
+	Head         *BlockHead
+	Sign         *crypto.Signature
+	ShortTxIDs   []uint64
+	PrefilledTxs map[int32]*tx.Tx
+}
+
+// NewCompactBlock returns the compact block of block, the base tx is prefilled since it can't be in others' txpool.
+func NewCompactBlock(b *Block) *CompactBlock {
+	c := &CompactBlock{
+		hash:         b.HeadHash(),
+		Head:         b.Head,
+		Sign:         b.Sign,
+		ShortTxIDs:   make([]uint64, 0, len(b.Txs)),
+		PrefilledTxs: make(map[int32]*tx.Tx),
+	}
+	for i, t := range b.Txs {
+		c.ShortTxIDs = append(c.ShortTxIDs, t.ShortID())
+		if i == 0 {
+			c.PrefilledTxs[int32(i)] = t
+		}
+	}
+	return c
+}
+
+// ToPb convert to protobuf
+func (c *CompactBlock) ToPb() *blockpb.CompactBlock {
+	cb := &blockpb.CompactBlock{
+		Head:         c.Head.ToPb(),
+		ShortTxIDs:   c.ShortTxIDs,
+		PrefilledTxs: make(map[int32]*txpb.Tx),
+	}
+	for i, t := range c.PrefilledTxs {
+		cb.PrefilledTxs[i] = t.ToPb()
+	}
+	if c.Sign != nil {
+		cb.Sign = c.Sign.ToPb()
+	}
+	return cb
+}
+
+// FromPb convert from protobuf
+func (c *CompactBlock) FromPb(cb *blockpb.CompactBlock) *CompactBlock {
+	h := &BlockHead{}
+	h.FromPb(cb.Head)
+	c.Head = h
+
+	sig := &crypto.Signature{}
+	c.Sign = sig.FromPb(cb.Sign)
+	c.ShortTxIDs = cb.ShortTxIDs
+	c.PrefilledTxs = make(map[int32]*tx.Tx, len(cb.PrefilledTxs))
+	for i, t := range cb.PrefilledTxs {
+		tt := &tx.Tx{}
+		c.PrefilledTxs[i] = tt.FromPb(t)
+	}
+	c.hash = c.Head.hash()
+	return c
+}
+
+// Encode is marshal
+func (c *CompactBlock) Encode() ([]byte, error) {
+	cbByte, err := proto.Marshal(c.ToPb())
+	if err != nil {
+		return nil, errors.New("fail to encode compact block")
+	}
+	return cbByte, nil
+}
+
+// Decode is unmarshal
+func (c *CompactBlock) Decode(cbByte []byte) error {
+	cb := &blockpb.CompactBlock{}
+	err := proto.Unmarshal(cbByte, cb)
+	if err != nil || cb.Head == nil || cb.Sign == nil {
+		return errors.New("fail to decode compact block")
+	}
+	c.FromPb(cb)
+	return nil
+}
+
+// HeadHash return block hash
+func (c *CompactBlock) HeadHash() []byte {
+	return c.hash
+}
+
+// Reconstruct returns the block filled with the prefilled txs and the ones found by lookup,
+// and the indexes of missing txs which are left nil in the block. The receipts of the block are nil.
+func (c *CompactBlock) Reconstruct(lookup func(shortID uint64) *tx.Tx) (*Block, []int32) {
+	b := &Block{
+		hash: c.hash,
+		Head: c.Head,
+		Sign: c.Sign,
+		Txs:  make([]*tx.Tx, len(c.ShortTxIDs)),
+	}
+	missing := make([]int32, 0)
+	for i, id := range c.ShortTxIDs {
+		if t, ok := c.PrefilledTxs[int32(i)]; ok {
+			b.Txs[i] = t
+			continue
+		}
+		if t := lookup(id); t != nil {
+			b.Txs[i] = t
+			continue
+		}
+		missing = append(missing, int32(i))
+	}
+	return b, missing
+}
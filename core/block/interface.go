@@ -20,6 +20,8 @@ type Chain interface {
 	GetReceipt(Hash []byte) (*tx.TxReceipt, error)
 	GetReceiptByTxHash(Hash []byte) (*tx.TxReceipt, error)
 	HasReceipt(hash []byte) (bool, error)
+	PutStateDiffs(blockHash []byte, diffs map[string][]*tx.StateChange) error
+	GetStateDiffByTxHash(hash []byte) ([]*tx.StateChange, error)
 	Size() (int64, error)
 	Close()
 	Draw(int64, int64) string
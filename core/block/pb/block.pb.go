@@ -266,6 +266,77 @@ func (x *Block) GetBlockType() BlockType {
 	return BlockType_NORMAL
 }
 
+type CompactBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Head         *BlockHead        `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	Sign         *pb.Signature     `protobuf:"bytes,2,opt,name=sign,proto3" json:"sign,omitempty"`
+	ShortTxIDs   []uint64          `protobuf:"fixed64,4,rep,packed,name=shortTxIDs,proto3" json:"shortTxIDs,omitempty"`
+	PrefilledTxs map[int32]*pb1.Tx `protobuf:"bytes,5,rep,name=prefilledTxs,proto3" json:"prefilledTxs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *CompactBlock) Reset() {
+	*x = CompactBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_block_pb_block_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompactBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactBlock) ProtoMessage() {}
+
+func (x *CompactBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_core_block_pb_block_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactBlock.ProtoReflect.Descriptor instead.
+func (*CompactBlock) Descriptor() ([]byte, []int) {
+	return file_core_block_pb_block_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CompactBlock) GetHead() *BlockHead {
+	if x != nil {
+		return x.Head
+	}
+	return nil
+}
+
+func (x *CompactBlock) GetSign() *pb.Signature {
+	if x != nil {
+		return x.Sign
+	}
+	return nil
+}
+
+func (x *CompactBlock) GetShortTxIDs() []uint64 {
+	if x != nil {
+		return x.ShortTxIDs
+	}
+	return nil
+}
+
+func (x *CompactBlock) GetPrefilledTxs() map[int32]*pb1.Tx {
+	if x != nil {
+		return x.PrefilledTxs
+	}
+	return nil
+}
+
 var File_core_block_pb_block_proto protoreflect.FileDescriptor
 
 var file_core_block_pb_block_proto_rawDesc = []byte{
@@ -307,13 +378,31 @@ var file_core_block_pb_block_proto_rawDesc = []byte{
 	0x30, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01,
 	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f,
 	0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x79, 0x70,
-	0x65, 0x2a, 0x25, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a,
-	0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x4e,
-	0x4c, 0x59, 0x48, 0x41, 0x53, 0x48, 0x10, 0x01, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6f, 0x73, 0x74, 0x2d, 0x6f, 0x66, 0x66, 0x69,
-	0x63, 0x69, 0x61, 0x6c, 0x2f, 0x67, 0x6f, 0x2d, 0x69, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x33, 0x2f,
-	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x22, 0x9a, 0x02, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x26, 0x0a, 0x04, 0x68, 0x65, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x61, 0x64, 0x52, 0x04, 0x68, 0x65, 0x61, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x73, 0x69,
+	0x67, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x69, 0x67, 0x70, 0x62,
+	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x54, 0x78, 0x49, 0x44, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x06, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x54, 0x78, 0x49, 0x44, 0x73,
+	0x12, 0x4b, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x54, 0x78, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x54, 0x78, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0c, 0x70, 0x72, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x54, 0x78, 0x73, 0x1a, 0x49, 0x0a,
+	0x11, 0x50, 0x72, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x54, 0x78, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x74, 0x78, 0x70, 0x62, 0x2e, 0x54, 0x78, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x2a, 0x25,
+	0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e,
+	0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x4e, 0x4c, 0x59, 0x48,
+	0x41, 0x53, 0x48, 0x10, 0x01, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6f, 0x73, 0x74, 0x2d, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61,
+	0x6c, 0x2f, 0x67, 0x6f, 0x2d, 0x69, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x72,
+	0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -329,26 +418,32 @@ func file_core_block_pb_block_proto_rawDescGZIP() []byte {
 }
 
 var file_core_block_pb_block_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_core_block_pb_block_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_core_block_pb_block_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_core_block_pb_block_proto_goTypes = []any{
 	(BlockType)(0),        // 0: blockpb.BlockType
 	(*BlockHead)(nil),     // 1: blockpb.BlockHead
 	(*Block)(nil),         // 2: blockpb.Block
-	(*pb.Signature)(nil),  // 3: sigpb.Signature
-	(*pb1.Tx)(nil),        // 4: txpb.Tx
-	(*pb1.TxReceipt)(nil), // 5: txpb.TxReceipt
+	(*CompactBlock)(nil),  // 3: blockpb.CompactBlock
+	nil,                   // 4: blockpb.CompactBlock.PrefilledTxsEntry
+	(*pb.Signature)(nil),  // 5: sigpb.Signature
+	(*pb1.Tx)(nil),        // 6: txpb.Tx
+	(*pb1.TxReceipt)(nil), // 7: txpb.TxReceipt
 }
 var file_core_block_pb_block_proto_depIdxs = []int32{
 	1, // 0: blockpb.Block.head:type_name -> blockpb.BlockHead
-	3, // 1: blockpb.Block.sign:type_name -> sigpb.Signature
-	4, // 2: blockpb.Block.txs:type_name -> txpb.Tx
-	5, // 3: blockpb.Block.receipts:type_name -> txpb.TxReceipt
+	5, // 1: blockpb.Block.sign:type_name -> sigpb.Signature
+	6, // 2: blockpb.Block.txs:type_name -> txpb.Tx
+	7, // 3: blockpb.Block.receipts:type_name -> txpb.TxReceipt
 	0, // 4: blockpb.Block.blockType:type_name -> blockpb.BlockType
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	1, // 5: blockpb.CompactBlock.head:type_name -> blockpb.BlockHead
+	5, // 6: blockpb.CompactBlock.sign:type_name -> sigpb.Signature
+	4, // 7: blockpb.CompactBlock.prefilledTxs:type_name -> blockpb.CompactBlock.PrefilledTxsEntry
+	6, // 8: blockpb.CompactBlock.PrefilledTxsEntry.value:type_name -> txpb.Tx
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_core_block_pb_block_proto_init() }
@@ -381,6 +476,18 @@ func file_core_block_pb_block_proto_init() {
 				return nil
 			}
 		}
+		file_core_block_pb_block_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*CompactBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -388,7 +495,7 @@ func file_core_block_pb_block_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_core_block_pb_block_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
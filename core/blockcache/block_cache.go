@@ -2,6 +2,7 @@ package blockcache
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -9,6 +10,8 @@ import (
 
 	"github.com/iost-official/go-iost/v3/common"
 	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/core/event"
+	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/db"
 	"github.com/iost-official/go-iost/v3/db/wal"
 	"github.com/iost-official/go-iost/v3/ilog"
@@ -55,6 +58,9 @@ type BlockCacheNode struct { //nolint:golint
 	walIndex     uint64
 	ValidWitness []string
 	SerialNum    int64
+	// StateDiffs are the state changes of txs recorded in execution, they are written to block chain
+	// when the block becomes irreversible, and are dropped with the node otherwise.
+	StateDiffs map[string][]*tx.StateChange
 }
 
 // GetParent returns the node's parent node.
@@ -516,8 +522,13 @@ func (bc *BlockCacheImpl) Link(bcn *BlockCacheNode) {
 	}
 
 	if bcn.Head.Number > bc.Head().Head.Number || (bcn.Head.Number == bc.Head().Head.Number && bcn.Head.Time < bc.Head().Head.Time) {
+		oldHead := bc.Head()
 		bc.SetHead(bcn)
+		if bcn.GetParent() != oldHead {
+			bc.postChainReorg(branchOf(oldHead), bcn)
+		}
 	}
+	bc.postBlockEvent(event.NewBlock, bcn)
 }
 
 // AddNodeToWAL add write node message to WAL
@@ -677,6 +688,8 @@ func (bc *BlockCacheImpl) updateLinkedRoot(bcn *BlockCacheNode) {
 	if parent != bc.LinkedRoot() {
 		ilog.Errorf("block isn't blockcache root's child")
 	}
+	// the branch of head should be saved before it is deleted
+	oldBranch := branchOf(bc.Head())
 	for child := range parent.Children {
 		if child != bcn {
 			bc.del(child)
@@ -703,6 +716,67 @@ func (bc *BlockCacheImpl) updateLinkedRoot(bcn *BlockCacheNode) {
 			bc.SetHead(bcn)
 		}
 	}
+	bc.postChainReorg(oldBranch, bc.Head())
+}
+
+// branchOf returns the nodes from bcn up to the root of its tree.
+func branchOf(bcn *BlockCacheNode) []*BlockCacheNode {
+	branch := make([]*BlockCacheNode, 0)
+	for ; bcn != nil; bcn = bcn.GetParent() {
+		branch = append(branch, bcn)
+	}
+	return branch
+}
+
+func (bc *BlockCacheImpl) postBlockEvent(topic event.Topic, bcn *BlockCacheNode) {
+	data, err := json.Marshal(event.NewBlockData(bcn.Block))
+	if err != nil {
+		ilog.Errorf("Marshal %v event failed: %v", topic, err)
+		return
+	}
+	event.GetCollector().Post(event.NewEvent(topic, string(data)), nil)
+}
+
+// postChainReorg posts a ChainReorg event if the new head isn't on the old branch.
+func (bc *BlockCacheImpl) postChainReorg(oldBranch []*BlockCacheNode, newHead *BlockCacheNode) {
+	if len(oldBranch) == 0 || oldBranch[0] == newHead {
+		return
+	}
+	newBranch := branchOf(newHead)
+	onNewBranch := make(map[*BlockCacheNode]int, len(newBranch))
+	for i, n := range newBranch {
+		onNewBranch[n] = i
+	}
+	// the old linked root is the fork point if the old head was pruned
+	fork, dropped, added := oldBranch[len(oldBranch)-1], oldBranch[:len(oldBranch)-1], newBranch
+	for i, n := range oldBranch {
+		if j, ok := onNewBranch[n]; ok {
+			fork, dropped, added = n, oldBranch[:i], newBranch[:j]
+			break
+		}
+	}
+	if len(dropped) == 0 {
+		return
+	}
+	data := &event.ChainReorgData{
+		ForkNumber: fork.Head.Number,
+		ForkHash:   common.Base58Encode(fork.HeadHash()),
+		Dropped:    make([]string, 0, len(dropped)),
+		Added:      make([]string, 0, len(added)),
+	}
+	for i := len(dropped) - 1; i >= 0; i-- {
+		data.Dropped = append(data.Dropped, common.Base58Encode(dropped[i].HeadHash()))
+	}
+	for i := len(added) - 1; i >= 0; i-- {
+		data.Added = append(data.Added, common.Base58Encode(added[i].HeadHash()))
+	}
+	b, err := json.Marshal(data)
+	if err != nil {
+		ilog.Errorf("Marshal %v event failed: %v", event.ChainReorg, err)
+		return
+	}
+	ilog.Infof("Chain reorganized at block %v, dropped %v blocks, added %v blocks", fork.Head.Number, len(data.Dropped), len(data.Added))
+	event.GetCollector().Post(event.NewEvent(event.ChainReorg, string(b)), nil)
 }
 
 func (bc *BlockCacheImpl) flush() {
@@ -713,6 +787,12 @@ func (bc *BlockCacheImpl) flush() {
 	if err != nil {
 		ilog.Errorf("Push blockchain error: %v %v", common.Base58Encode(bcn.HeadHash()), err)
 	}
+	if bcn.StateDiffs != nil {
+		if err := bc.blockChain.PutStateDiffs(bcn.HeadHash(), bcn.StateDiffs); err != nil {
+			ilog.Errorf("Record state diffs of block %v failed, err: %v", common.Base58Encode(bcn.HeadHash()), err)
+		}
+		bcn.StateDiffs = nil
+	}
 
 	err = bc.writeUpdateLinkedRootWitnessWAL()
 	if err != nil {
@@ -723,6 +803,7 @@ func (bc *BlockCacheImpl) flush() {
 	if err != nil {
 		ilog.Errorf("Flush state db error: %v %v", common.Base58Encode(bcn.HeadHash()), err)
 	}
+	bc.postBlockEvent(event.LinkedRootChanged, bcn)
 
 	err = bc.wal.RemoveFilesBefore(bcn.walIndex)
 	if err != nil {
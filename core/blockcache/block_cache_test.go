@@ -3,7 +3,9 @@ package blockcache
 import (
 	"encoding/json"
 	"os"
+	"reflect"
 	"testing"
+	"time"
 
 	. "github.com/golang/mock/gomock"
 	core_mock "github.com/iost-official/go-iost/v3/core/mocks"
@@ -11,6 +13,8 @@ import (
 
 	"github.com/iost-official/go-iost/v3/common"
 	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/core/event"
+	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/vm/database"
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -189,6 +193,75 @@ func TestBlockCache(t *testing.T) {
 
 		})
 
+		Convey("StateDiffs", func() {
+			os.RemoveAll(BlockCacheWALDir)
+			bc, _ := NewBlockCache(config, base, statedb)
+			defer CleanDir(bc)
+			diffs := map[string][]*tx.StateChange{"tx": {{Contract: "token.iost", Key: "k", NewValue: "v"}}}
+			base.EXPECT().PutStateDiffs(b1.HeadHash(), diffs).Times(1).Return(nil)
+			b1node := bc.Add(b1)
+			b1node.StateDiffs = diffs
+			bc.Link(b1node)
+			b2node := bc.Add(b2)
+			bc.Link(b2node)
+			b2anode := bc.Add(b2a)
+			b2anode.StateDiffs = diffs
+			bc.Link(b2anode)
+
+			// the diffs are written when the block is irreversible, and dropped with the forked block
+			bc.updateLinkedRoot(b1node)
+			bc.flush()
+			So(b1node.StateDiffs, ShouldBeNil)
+			bc.updateLinkedRoot(b2node)
+			bc.flush()
+		})
+
+		Convey("ChainReorg", func() {
+			os.RemoveAll(BlockCacheWALDir)
+			bc, _ := NewBlockCache(config, base, statedb)
+			defer CleanDir(bc)
+			ec := event.GetCollector()
+			ch := ec.Subscribe(1, []event.Topic{event.ChainReorg}, nil)
+			defer ec.Unsubscribe(1, []event.Topic{event.ChainReorg})
+			// The events are posted asynchronously, so the late ones of the other cases are skipped.
+			waitReorg := func(expected *event.ChainReorgData) *event.ChainReorgData {
+				var data *event.ChainReorgData
+				for {
+					select {
+					case e := <-ch:
+						data = &event.ChainReorgData{}
+						So(json.Unmarshal([]byte(e.Data), data), ShouldBeNil)
+						if reflect.DeepEqual(data, expected) {
+							return data
+						}
+					case <-time.After(time.Second):
+						return data
+					}
+				}
+			}
+
+			for _, b := range []*block.Block{b1, b2} {
+				bc.Link(bc.Add(b))
+			}
+			bc.Link(bc.Add(b2a))
+			expected := &event.ChainReorgData{
+				ForkNumber: b1.Head.Number,
+				ForkHash:   common.Base58Encode(b1.HeadHash()),
+				Dropped:    []string{common.Base58Encode(b2.HeadHash())},
+				Added:      []string{common.Base58Encode(b2a.HeadHash())},
+			}
+			So(waitReorg(expected), ShouldResemble, expected)
+
+			bc.Link(bc.Add(b3))
+			expected = &event.ChainReorgData{
+				ForkNumber: b1.Head.Number,
+				ForkHash:   common.Base58Encode(b1.HeadHash()),
+				Dropped:    []string{common.Base58Encode(b2a.HeadHash())},
+				Added:      []string{common.Base58Encode(b2.HeadHash()), common.Base58Encode(b3.HeadHash())},
+			}
+			So(waitReorg(expected), ShouldResemble, expected)
+		})
+
 		Convey("UpdateInfo", func() {
 			os.RemoveAll(BlockCacheWALDir)
 			bc, err := NewBlockCache(config, base, statedb)
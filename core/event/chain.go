@@ -0,0 +1,62 @@
+package event
+
+import (
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/block"
+)
+
+// BlockData is the json data of NewBlock and LinkedRootChanged events.
+type BlockData struct {
+	Number     int64  `json:"number"`
+	Hash       string `json:"hash"`
+	ParentHash string `json:"parent_hash"`
+	Witness    string `json:"witness"`
+	Time       int64  `json:"time"`
+	TxCount    int    `json:"tx_count"`
+}
+
+// NewBlockData returns the event data of the block.
+func NewBlockData(blk *block.Block) *BlockData {
+	return &BlockData{
+		Number:     blk.Head.Number,
+		Hash:       common.Base58Encode(blk.HeadHash()),
+		ParentHash: common.Base58Encode(blk.Head.ParentHash),
+		Witness:    blk.Head.Witness,
+		Time:       blk.Head.Time,
+		TxCount:    len(blk.Txs),
+	}
+}
+
+// ChainReorgData is the json data of ChainReorg event.
+// Dropped and Added are block hashes after the fork point in ascending order of number.
+type ChainReorgData struct {
+	ForkNumber int64    `json:"fork_number"`
+	ForkHash   string   `json:"fork_hash"`
+	Dropped    []string `json:"dropped"`
+	Added      []string `json:"added"`
+}
+
+// cursorIndexBits is the number of low bits of Cursor used by the index in block.
+const cursorIndexBits = 20
+
+// MaxCursorIndex is the max index of events in a block.
+const MaxCursorIndex = 1<<cursorIndexBits - 1
+
+// Cursor is the position of an event in the irreversible chain.
+// It increases monotonically with the block number and the index of the event in the block.
+type Cursor uint64
+
+// NewCursor returns the cursor of the index-th event in block number.
+func NewCursor(number int64, index int) Cursor {
+	return Cursor(uint64(number)<<cursorIndexBits | uint64(index&MaxCursorIndex))
+}
+
+// BlockNumber returns the block number of the cursor.
+func (c Cursor) BlockNumber() int64 {
+	return int64(c >> cursorIndexBits)
+}
+
+// Index returns the index of the event in block.
+func (c Cursor) Index() int {
+	return int(c & MaxCursorIndex)
+}
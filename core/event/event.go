@@ -5,6 +5,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/ilog"
 )
 
@@ -18,6 +19,10 @@ type Topic int
 const (
 	ContractReceipt Topic = iota
 	ContractEvent
+	NewBlock
+	LinkedRootChanged
+	ChainReorg
+	PendingTx
 )
 
 func (t Topic) String() string {
@@ -26,6 +31,14 @@ func (t Topic) String() string {
 		return "ContractReceipt"
 	case ContractEvent:
 		return "ContractEvent"
+	case NewBlock:
+		return "NewBlock"
+	case LinkedRootChanged:
+		return "LinkedRootChanged"
+	case ChainReorg:
+		return "ChainReorg"
+	case PendingTx:
+		return "PendingTx"
 	default:
 		return "unknown_topic:" + strconv.Itoa(int(t))
 	}
@@ -50,24 +63,17 @@ func NewEvent(topic Topic, data string) *Event {
 // Meta is the information abount event.
 type Meta struct {
 	ContractID string
-}
-
-// Match checks whether the given meta argument is matched to self.
-func (m *Meta) Match(meta *Meta) bool {
-	if meta == nil {
-		return true
-	}
-
-	if m.ContractID != "" && m.ContractID != meta.ContractID {
-		return false
-	}
-	return true
+	ActionName string
+	Publisher  string
+	// Signers are the accounts who signed the tx, including the publisher.
+	Signers    []string
+	StatusCode tx.StatusCode
 }
 
 // Subscription is a struct used for listening specific topics
 type Subscription struct {
 	C      chan<- *Event
-	filter *Meta
+	filter *Filter
 }
 
 var ec *Collector
@@ -87,7 +93,7 @@ func GetCollector() *Collector {
 }
 
 // Subscribe registers a subscription in event collector.
-func (ec *Collector) Subscribe(id int64, topics []Topic, filter *Meta) <-chan *Event {
+func (ec *Collector) Subscribe(id int64, topics []Topic, filter *Filter) <-chan *Event {
 	c := make(chan *Event, EventChSize)
 	for _, topic := range topics {
 		m, _ := ec.subMap.LoadOrStore(topic, new(sync.Map))
@@ -112,7 +118,7 @@ func (ec *Collector) sendEvent(e *Event, meta *Meta) {
 	if m, exist := ec.subMap.Load(e.Topic); exist {
 		m.(*sync.Map).Range(func(k, v any) bool {
 			sub := v.(*Subscription)
-			if sub.filter != nil && !sub.filter.Match(meta) {
+			if sub.filter != nil && !sub.filter.Match(e, meta) {
 				return true
 			}
 			select {
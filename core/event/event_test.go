@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/iost-official/go-iost/v3/core/event"
+	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/ilog"
 	"github.com/stretchr/testify/assert"
 )
@@ -15,7 +16,7 @@ func TestEventCollectorPost(t *testing.T) {
 	ec := event.GetCollector()
 
 	ch1 := ec.Subscribe(1, []event.Topic{event.ContractEvent}, nil)
-	ch2 := ec.Subscribe(2, []event.Topic{event.ContractEvent}, &event.Meta{ContractID: "base.iost"})
+	ch2 := ec.Subscribe(2, []event.Topic{event.ContractEvent}, &event.Filter{ContractID: "base.iost"})
 	ch3 := ec.Subscribe(3, []event.Topic{event.ContractReceipt, event.ContractEvent}, nil)
 
 	count1 := int32(0)
@@ -110,3 +111,59 @@ func TestEventCollectorFullPost(t *testing.T) {
 
 	assert.EqualValues(t, event.EventChSize, atomic.LoadInt32(&count))
 }
+
+func TestCursor(t *testing.T) {
+	c := event.NewCursor(123456, 7)
+	assert.EqualValues(t, 123456, c.BlockNumber())
+	assert.Equal(t, 7, c.Index())
+	assert.True(t, event.NewCursor(123456, event.MaxCursorIndex) < event.NewCursor(123457, 0))
+	assert.True(t, event.NewCursor(123456, 7) < event.NewCursor(123456, 8))
+}
+
+func TestFilter(t *testing.T) {
+	meta := &event.Meta{
+		ContractID: "token.iost",
+		ActionName: "transfer",
+		Publisher:  "admin",
+		Signers:    []string{"admin", "bob"},
+		StatusCode: tx.Success,
+	}
+	e := event.NewEvent(event.ContractReceipt, `["iost","admin","alice","100.5",""]`)
+
+	mustParse := func(s string) *event.Predicate {
+		p, err := event.ParsePredicate(s)
+		assert.Nil(t, err)
+		return p
+	}
+	cases := []struct {
+		filter *event.Filter
+		match  bool
+	}{
+		{&event.Filter{}, true},
+		{&event.Filter{ContractID: "token.iost", ActionName: "transfer"}, true},
+		{&event.Filter{ActionName: "issue"}, false},
+		{&event.Filter{Signer: "bob"}, true},
+		{&event.Filter{Signer: "carol"}, false},
+		{&event.Filter{StatusCodes: []tx.StatusCode{tx.ErrorRuntime}}, false},
+		{&event.Filter{StatusCodes: []tx.StatusCode{tx.ErrorRuntime, tx.Success}}, true},
+		{&event.Filter{Predicates: []*event.Predicate{mustParse(`$[2] == "alice"`)}}, true},
+		{&event.Filter{Predicates: []*event.Predicate{mustParse(`[2] != alice`)}}, false},
+		{&event.Filter{Predicates: []*event.Predicate{mustParse(`[3] > 100`), mustParse(`[3]<=100.5`)}}, true},
+		{&event.Filter{Predicates: []*event.Predicate{mustParse(`[3] >= 101`)}}, false},
+		{&event.Filter{Predicates: []*event.Predicate{mustParse(`[9] == 1`)}}, false},
+	}
+	for i, c := range cases {
+		assert.Equal(t, c.match, c.filter.Match(e, meta), "case %d", i)
+	}
+
+	e = event.NewEvent(event.ContractEvent, `{"to":"alice","data":{"list":[1,{"ok":true}]}}`)
+	f := &event.Filter{Predicates: []*event.Predicate{mustParse(`to == "alice"`), mustParse(`$.data.list[1].ok == true`)}}
+	assert.True(t, f.Match(e, nil))
+	f = &event.Filter{Predicates: []*event.Predicate{mustParse(`to == "bob"`)}}
+	assert.False(t, f.Match(e, nil))
+
+	_, err := event.ParsePredicate("to alice")
+	assert.NotNil(t, err)
+	_, err = event.ParsePredicate("[x] == 1")
+	assert.NotNil(t, err)
+}
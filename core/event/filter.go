@@ -0,0 +1,220 @@
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/iost-official/go-iost/v3/core/tx"
+)
+
+// Filter is the condition of events that a subscription receives. Empty fields match any event.
+type Filter struct {
+	ContractID string
+	ActionName string
+	// Signer matches the publisher or any signer of the tx.
+	Signer      string
+	StatusCodes []tx.StatusCode
+	// Predicates are evaluated over the json data of the event, all of them should be true.
+	Predicates []*Predicate
+}
+
+// Match checks whether the event with meta matches the filter.
+func (f *Filter) Match(e *Event, meta *Meta) bool {
+	if meta == nil {
+		meta = &Meta{}
+	}
+	if f.ContractID != "" && f.ContractID != meta.ContractID {
+		return false
+	}
+	if f.ActionName != "" && f.ActionName != meta.ActionName {
+		return false
+	}
+	if f.Signer != "" && f.Signer != meta.Publisher && !containsString(meta.Signers, f.Signer) {
+		return false
+	}
+	if len(f.StatusCodes) > 0 {
+		found := false
+		for _, c := range f.StatusCodes {
+			if c == meta.StatusCode {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(f.Predicates) > 0 {
+		var data any
+		if err := json.Unmarshal([]byte(e.Data), &data); err != nil {
+			return false
+		}
+		for _, p := range f.Predicates {
+			if !p.Eval(data) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// predicate operators, two-character operators should be matched first
+var predicateOps = []string{"==", "!=", ">=", "<=", ">", "<"}
+
+// Predicate is a comparison between the value at a path of json data and a constant, such as `to == "alice"`.
+type Predicate struct {
+	path  []any // string for object key and int for array index
+	op    string
+	value any
+}
+
+// ParsePredicate parses a predicate of the form `path op value`.
+// The path is made of object keys and array indexes, such as `$.data[0].to`, the leading `$` is optional.
+// The op is one of ==, !=, >, >=, <, <=.
+// The value is a json literal, or a raw string if it isn't valid json.
+func ParsePredicate(s string) (*Predicate, error) {
+	pos, op := -1, ""
+	for i := 0; i < len(s) && pos < 0; i++ {
+		for _, o := range predicateOps {
+			if strings.HasPrefix(s[i:], o) {
+				pos, op = i, o
+				break
+			}
+		}
+	}
+	if pos < 0 {
+		return nil, fmt.Errorf("no operator in predicate %q", s)
+	}
+	path, err := parsePath(strings.TrimSpace(s[:pos]))
+	if err != nil {
+		return nil, err
+	}
+	raw := strings.TrimSpace(s[pos+len(op):])
+	if raw == "" {
+		return nil, fmt.Errorf("no value in predicate %q", s)
+	}
+	var value any
+	if err := json.Unmarshal([]byte(raw), &value); err != nil {
+		value = raw
+	}
+	return &Predicate{path: path, op: op, value: value}, nil
+}
+
+func parsePath(s string) ([]any, error) {
+	s = strings.TrimPrefix(s, "$")
+	path := make([]any, 0)
+	for s != "" {
+		switch s[0] {
+		case '.':
+			s = s[1:]
+		case '[':
+			end := strings.IndexByte(s, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed [ in path")
+			}
+			idx, err := strconv.Atoi(s[1:end])
+			if err != nil || idx < 0 {
+				return nil, fmt.Errorf("invalid array index %q in path", s[1:end])
+			}
+			path = append(path, idx)
+			s = s[end+1:]
+		default:
+			end := strings.IndexAny(s, ".[")
+			if end < 0 {
+				end = len(s)
+			}
+			path = append(path, s[:end])
+			s = s[end:]
+		}
+	}
+	return path, nil
+}
+
+// Eval returns whether the predicate is true for the json data.
+func (p *Predicate) Eval(data any) bool {
+	v, ok := lookup(data, p.path)
+	if !ok {
+		return false
+	}
+	if a, aok := toNumber(v); aok {
+		if b, bok := toNumber(p.value); bok {
+			return compare(p.op, a < b, a == b)
+		}
+	}
+	if a, aok := v.(string); aok {
+		if b, bok := p.value.(string); bok {
+			return compare(p.op, a < b, a == b)
+		}
+	}
+	switch p.op {
+	case "==":
+		return reflect.DeepEqual(v, p.value)
+	case "!=":
+		return !reflect.DeepEqual(v, p.value)
+	}
+	return false
+}
+
+func compare(op string, less, equal bool) bool {
+	switch op {
+	case "==":
+		return equal
+	case "!=":
+		return !equal
+	case ">":
+		return !less && !equal
+	case ">=":
+		return !less
+	case "<":
+		return less
+	case "<=":
+		return less || equal
+	}
+	return false
+}
+
+func lookup(data any, path []any) (any, bool) {
+	for _, k := range path {
+		switch key := k.(type) {
+		case string:
+			m, ok := data.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			if data, ok = m[key]; !ok {
+				return nil, false
+			}
+		case int:
+			a, ok := data.([]any)
+			if !ok || key >= len(a) {
+				return nil, false
+			}
+			data = a[key]
+		}
+	}
+	return data, true
+}
+
+// toNumber converts json numbers and numeric strings, such as token amounts, to float64.
+func toNumber(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
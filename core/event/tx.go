@@ -0,0 +1,55 @@
+package event
+
+import (
+	"strings"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/tx"
+)
+
+// ActionData is the contract and action name of an action.
+type ActionData struct {
+	Contract   string `json:"contract"`
+	ActionName string `json:"action_name"`
+}
+
+// PendingTxData is the json data of PendingTx event.
+type PendingTxData struct {
+	Hash       string        `json:"hash"`
+	Publisher  string        `json:"publisher"`
+	Time       int64         `json:"time"`
+	Expiration int64         `json:"expiration"`
+	GasRatio   float64       `json:"gas_ratio"`
+	GasLimit   float64       `json:"gas_limit"`
+	Actions    []*ActionData `json:"actions"`
+}
+
+// NewPendingTxData returns the event data of the tx.
+func NewPendingTxData(t *tx.Tx) *PendingTxData {
+	d := &PendingTxData{
+		Hash:       common.Base58Encode(t.Hash()),
+		Publisher:  t.Publisher,
+		Time:       t.Time,
+		Expiration: t.Expiration,
+		GasRatio:   float64(t.GasRatio) / 100,
+		GasLimit:   float64(t.GasLimit) / 100,
+		Actions:    make([]*ActionData, 0, len(t.Actions)),
+	}
+	for _, a := range t.Actions {
+		d.Actions = append(d.Actions, &ActionData{Contract: a.Contract, ActionName: a.ActionName})
+	}
+	return d
+}
+
+// NewPendingTxMeta returns the meta of PendingTx event, the contract and action name are of the first action.
+func NewPendingTxMeta(t *tx.Tx) *Meta {
+	meta := &Meta{Publisher: t.Publisher}
+	if len(t.Actions) > 0 {
+		meta.ContractID = t.Actions[0].Contract
+		meta.ActionName = t.Actions[0].ActionName
+	}
+	for _, s := range t.Signers {
+		meta.Signers = append(meta.Signers, strings.Split(s, "@")[0])
+	}
+	return meta
+}
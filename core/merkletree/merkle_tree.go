@@ -1,6 +1,7 @@
 package merkletree
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
 	"math"
@@ -69,6 +70,50 @@ func (m *MerkleTree) MerklePath(hash []byte) ([][]byte, error) {
 	return mp, nil
 }
 
+// MerkleProof returns the index of the leaf and the sibling hashes from the leaf up to the root,
+// which can be checked by VerifyMerkleProof.
+func (m *MerkleTree) MerkleProof(hash []byte) (int32, [][]byte, error) {
+	if m.LeafNum == 0 {
+		return 0, nil, errors.New("merkletree hasn't built")
+	}
+	idx, ok := m.Hash2Idx[hex.EncodeToString(hash)]
+	if !ok {
+		return 0, nil, errors.New("hash isn't in the tree")
+	}
+	if m.LeafNum == 1 {
+		// the only leaf is hashed with itself
+		return 0, [][]byte{hash}, nil
+	}
+	mp, err := m.MerklePath(hash)
+	if err != nil {
+		return 0, nil, err
+	}
+	return idx - (m.LeafNum - 1), mp, nil
+}
+
+// VerifyMerkleProof returns whether the leaf at index hashes up to the root with the sibling hashes.
+func VerifyMerkleProof(leaf []byte, index int32, siblings [][]byte, root []byte) bool {
+	if len(leaf) == 0 || len(root) == 0 || index < 0 {
+		return false
+	}
+	hash := leaf
+	for _, sibling := range siblings {
+		// a node is hashed with itself only when it is the last left child of the level
+		if index%2 == 1 && bytes.Equal(hash, sibling) {
+			return false
+		}
+		buf := make([]byte, 0, len(hash)+len(sibling))
+		if index%2 == 0 {
+			buf = append(append(buf, hash...), sibling...)
+		} else {
+			buf = append(append(buf, sibling...), hash...)
+		}
+		hash = common.Sha3(buf)
+		index /= 2
+	}
+	return index == 0 && bytes.Equal(hash, root)
+}
+
 // MerkleProve is prove of the merkle tree
 //func (m *MerkleTree) MerkleProve(hash []byte, rootHash []byte, mp [][]byte) (bool, error) {
 //	if hash == nil {
@@ -65,6 +65,29 @@ func TestMerkleTree(t *testing.T) {
 	})
 }
 
+func TestMerkleProof(t *testing.T) {
+	Convey("Test of merkle proof", t, func() {
+		for _, n := range []int{1, 2, 3, 5, 8, 13} {
+			var data [][]byte
+			for i := 0; i < n; i++ {
+				data = append(data, RandHash(32))
+			}
+			m := MerkleTree{}
+			m.Build(data)
+			for i, leaf := range data {
+				index, siblings, err := m.MerkleProof(leaf)
+				So(err, ShouldBeNil)
+				So(index, ShouldEqual, i)
+				So(VerifyMerkleProof(leaf, index, siblings, m.RootHash()), ShouldBeTrue)
+				So(VerifyMerkleProof(leaf, index^1, siblings, m.RootHash()), ShouldBeFalse)
+				So(VerifyMerkleProof(RandHash(32), index, siblings, m.RootHash()), ShouldBeFalse)
+			}
+			_, _, err := m.MerkleProof(RandHash(32))
+			So(err, ShouldNotBeNil)
+		}
+	})
+}
+
 func BenchmarkBuild(b *testing.B) { // 646503ns = 0.6ms，vs 117729ns = 0.1ms
 	rand.Seed(time.Now().UnixNano())
 	var data [][]byte
@@ -2,6 +2,7 @@ package merkletree
 
 import (
 	"encoding/hex"
+	"errors"
 
 	"github.com/iost-official/go-iost/v3/core/tx"
 	"google.golang.org/protobuf/proto"
@@ -30,6 +31,19 @@ func (m *TXRMerkleTree) MerklePath(hash []byte) ([][]byte, error) {
 	return m.Mt.MerklePath(hash)
 }
 
+// MerkleProof returns the receipt hash of the tx, its index and the sibling hashes up to the root.
+func (m *TXRMerkleTree) MerkleProof(txHash []byte) ([]byte, int32, [][]byte, error) {
+	txrHash, ok := m.Tx2Txr[hex.EncodeToString(txHash)]
+	if !ok {
+		return nil, 0, nil, errors.New("tx isn't in the tree")
+	}
+	index, mp, err := m.Mt.MerkleProof(txrHash)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	return txrHash, index, mp, nil
+}
+
 // MerkleProve return prove of the merkle tree
 func (m *TXRMerkleTree) MerkleProve(hash []byte, rootHash []byte, mp [][]byte) (bool, error) {
 	//return m.Mt.MerkleProve(hash, rootHash, mp)
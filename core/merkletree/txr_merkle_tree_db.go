@@ -26,11 +26,11 @@ func Uint64ToBytes(n uint64) []byte {
 	return b
 }
 
-// Init is init the database
-func Init(levelDBPath string) error {
+// Init is init the database on the specify storage type
+func Init(levelDBPath string, storageType kv.StorageType) error {
 	var err error
 	once.Do(func() {
-		levelDB, tempErr := kv.NewStorage(levelDBPath+"TXRMerkleTreeDB", kv.LevelDBStorage)
+		levelDB, tempErr := kv.NewStorage(levelDBPath+"TXRMerkleTreeDB", storageType)
 		if tempErr != nil {
 			err = errors.New("fail to init TXRMerkleTreeDB")
 		}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/iost-official/go-iost/v3/core/tx"
+	"github.com/iost-official/go-iost/v3/db/kv"
 	"github.com/smartystreets/goconvey/convey"
 )
 
@@ -24,7 +25,7 @@ func TestTXRMerkleTreeDB(t *testing.T) {
 			tx.NewTxReceipt([]byte("node5")),
 		}
 		m.Build(txrs)
-		Init("./")
+		Init("./", kv.LevelDBStorage)
 		err := TXRMTDB.Put(&m, 32342)
 		if err != nil {
 			log.Panic(err)
@@ -45,7 +46,7 @@ func TestTXRMerkleTreeDB(t *testing.T) {
 
 func BenchmarkTXRMerkleTreeDB(b *testing.B) { //Put: 1544788ns = 1.5ms, Get: 621922ns = 0.6ms
 	rand.Seed(time.Now().UnixNano())
-	Init("./")
+	Init("./", kv.LevelDBStorage)
 	var txrs []*tx.TxReceipt
 	for i := 0; i < 3000; i++ {
 		txrs = append(txrs, tx.NewTxReceipt([]byte("node1")))
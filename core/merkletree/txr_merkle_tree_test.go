@@ -28,6 +28,12 @@ func TestTXRMerkleTree(t *testing.T) {
 		m.Build(txrs)
 		convey.So(hex.EncodeToString(m.Tx2Txr[hex.EncodeToString([]byte("node1"))]), convey.ShouldEqual, "4d0e8b99f37cd831bd42d0bd9a65f21982b06ea9addc43394923af0d55199a1c")
 
+		txrHash, index, mp, err := m.MerkleProof([]byte("node3"))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(txrHash, convey.ShouldResemble, txrs[2].Hash())
+		convey.So(index, convey.ShouldEqual, 2)
+		convey.So(VerifyMerkleProof(txrHash, index, mp, m.RootHash()), convey.ShouldBeTrue)
+
 		b, err := m.Encode()
 		if err != nil {
 			log.Panic(err)
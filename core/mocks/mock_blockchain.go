@@ -163,6 +163,35 @@ func (mr *MockChainMockRecorder) GetReceiptByTxHash(Hash interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceiptByTxHash", reflect.TypeOf((*MockChain)(nil).GetReceiptByTxHash), Hash)
 }
 
+// PutStateDiffs mocks base method.
+func (m *MockChain) PutStateDiffs(blockHash []byte, diffs map[string][]*tx.StateChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutStateDiffs", blockHash, diffs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutStateDiffs indicates an expected call of PutStateDiffs.
+func (mr *MockChainMockRecorder) PutStateDiffs(blockHash, diffs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutStateDiffs", reflect.TypeOf((*MockChain)(nil).PutStateDiffs), blockHash, diffs)
+}
+
+// GetStateDiffByTxHash mocks base method.
+func (m *MockChain) GetStateDiffByTxHash(hash []byte) ([]*tx.StateChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStateDiffByTxHash", hash)
+	ret0, _ := ret[0].([]*tx.StateChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStateDiffByTxHash indicates an expected call of GetStateDiffByTxHash.
+func (mr *MockChainMockRecorder) GetStateDiffByTxHash(hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStateDiffByTxHash", reflect.TypeOf((*MockChain)(nil).GetStateDiffByTxHash), hash)
+}
+
 // GetTx mocks base method.
 func (m *MockChain) GetTx(hash []byte) (*tx.Tx, error) {
 	m.ctrl.T.Helper()
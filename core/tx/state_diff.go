@@ -0,0 +1,24 @@
+package tx
+
+import "encoding/json"
+
+// StateChange is a storage change made by a tx. It is recorded by the node optionally and is not a part of consensus data.
+type StateChange struct {
+	Contract string `json:"contract"`
+	Key      string `json:"key"`
+	Field    string `json:"field,omitempty"`
+	OldValue string `json:"old_value"`
+	NewValue string `json:"new_value"`
+}
+
+// EncodeStateDiff encodes the state changes of a tx.
+func EncodeStateDiff(diff []*StateChange) ([]byte, error) {
+	return json.Marshal(diff)
+}
+
+// DecodeStateDiff decodes the state changes of a tx.
+func DecodeStateDiff(b []byte) ([]*StateChange, error) {
+	var diff []*StateChange
+	err := json.Unmarshal(b, &diff)
+	return diff, err
+}
@@ -1,6 +1,7 @@
 package tx
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"strconv"
@@ -18,14 +19,19 @@ import (
 const (
 	minGasRatio = 100
 	maxGasRatio = 10000
-	minGasLimit = 600000
-	maxGasLimit = 400000000
 	txSizeLimit = 65536
 )
 
+// range of gas limit, in the same unit as the gas usage of receipt
+const (
+	MinGasLimit = 600000
+	MaxGasLimit = 400000000
+)
+
 // values
 var (
 	MaxExpiration = int64(90 * time.Second)
+	MaxDelay      = int64(30 * 24 * time.Hour)
 	ChainID       uint32
 )
 
@@ -220,6 +226,11 @@ func (t *Tx) Hash() []byte {
 	return t.hash
 }
 
+// ShortID returns the first 8 bytes of tx hash, which identifies the tx in compact block.
+func (t *Tx) ShortID() uint64 {
+	return binary.BigEndian.Uint64(t.Hash()[:8])
+}
+
 // VerifySelf verify tx's signature and some base fields.
 func (t *Tx) VerifySelf() error { // nolint
 	if t.ChainID != ChainID {
@@ -228,7 +239,7 @@ func (t *Tx) VerifySelf() error { // nolint
 	if !(t.Time > 0 && t.Expiration > t.Time) {
 		return errors.New("invalid time and expiration")
 	}
-	if t.Delay != 0 {
+	if t.Delay < 0 || t.Delay > MaxDelay {
 		return errors.New("invalid delay time")
 	}
 	if err := t.CheckSize(); err != nil {
@@ -261,8 +272,34 @@ func (t *Tx) VerifySigner(sig *crypto.Signature) bool {
 	return sig.Verify(t.baseHash())
 }
 
+// IsDefer returns whether the tx is the deferred execution of a delay tx.
+func (t *Tx) IsDefer() bool {
+	return len(t.ReferredTx) > 0
+}
+
+// DeferTx returns the tx executed after the delay of t. It keeps all fields and signatures of t,
+// which don't cover ReferredTx, and refers to t by hash.
+func (t *Tx) DeferTx() *Tx {
+	d := *t
+	d.hash = nil
+	d.ReferredTx = t.Hash()
+	return &d
+}
+
+// ExecTime returns the earliest time to execute the tx, which is delayed for a deferred tx.
+func (t *Tx) ExecTime() int64 {
+	if t.IsDefer() {
+		return t.Time + t.Delay
+	}
+	return t.Time
+}
+
 // IsExpired checks whether the transaction is expired compared to the given time ct.
+// The expiration of a deferred tx is delayed too.
 func (t *Tx) IsExpired(ct int64) bool {
+	if t.IsDefer() {
+		ct -= t.Delay
+	}
 	if t.Expiration <= ct {
 		return true
 	}
@@ -275,7 +312,7 @@ func (t *Tx) IsExpired(ct int64) bool {
 // IsCreatedBefore checks whether the transaction time is valid compared to the given time ct.
 // ct may be time.Now().UnixNano() or block head time.
 func (t *Tx) IsCreatedBefore(ct int64) bool {
-	return t.Time <= ct
+	return t.ExecTime() <= ct
 }
 
 // CheckSize checks whether tx size is valid.
@@ -293,8 +330,8 @@ func (t *Tx) CheckGas() error {
 	if t.GasRatio < minGasRatio || t.GasRatio > maxGasRatio {
 		return fmt.Errorf("gas ratio illegal, should in [%v, %v]", minGasRatio/ratio, maxGasRatio/ratio)
 	}
-	if t.GasLimit < minGasLimit || t.GasLimit > maxGasLimit {
-		return fmt.Errorf("gas limit illegal, should in [%v, %v]", minGasLimit/ratio, maxGasLimit/ratio)
+	if t.GasLimit < MinGasLimit || t.GasLimit > MaxGasLimit {
+		return fmt.Errorf("gas limit illegal, should in [%v, %v]", MinGasLimit/ratio, MaxGasLimit/ratio)
 	}
 	return nil
 }
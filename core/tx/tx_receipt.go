@@ -101,6 +101,8 @@ type TxReceipt struct { //nolint:golint
 	Status   *Status
 	Returns  []string
 	Receipts []*Receipt
+	// StateDiff is not encoded, it is only filled in the receipts returned by rpc
+	StateDiff []*StateChange
 }
 
 // NewTxReceipt generate tx receipt for a tx hash
@@ -181,6 +181,32 @@ func TestTx(t *testing.T) {
 	})
 }
 
+func TestDeferTx(t *testing.T) {
+	Convey("Test of DeferTx", t, func() {
+		a1, _ := account.NewKeyPair(nil, crypto.Secp256k1)
+		ex := time.Now().UnixNano() + MaxExpiration
+		tx := NewTx([]*Action{NewAction("contract", "abi", "[]")}, []string{}, 1000000, 100, ex, int64(time.Hour), 0)
+		tx, err := SignTx(tx, "a1", []*account.KeyPair{a1})
+		So(err, ShouldBeNil)
+		So(tx.IsDefer(), ShouldBeFalse)
+
+		d := tx.DeferTx()
+		So(d.IsDefer(), ShouldBeTrue)
+		So(d.ReferredTx, ShouldResemble, tx.Hash())
+		So(d.Hash(), ShouldNotResemble, tx.Hash())
+		So(d.VerifySelf(), ShouldBeNil)
+
+		So(d.ExecTime(), ShouldEqual, tx.Time+int64(time.Hour))
+		So(d.IsCreatedBefore(tx.Time+int64(time.Minute)), ShouldBeFalse)
+		So(d.IsCreatedBefore(tx.Time+int64(time.Hour)), ShouldBeTrue)
+		So(d.IsExpired(tx.Time+int64(time.Hour)), ShouldBeFalse)
+		So(tx.IsExpired(tx.Time+int64(time.Hour)), ShouldBeTrue)
+
+		tx.Delay = MaxDelay + 1
+		So(tx.VerifySelf(), ShouldNotBeNil)
+	})
+}
+
 func TestTx_Platform(t *testing.T) {
 	//t.Skip()
 	//var sep = `\` + "`" + "^" + "/" + "<"
@@ -16,6 +16,8 @@ type TxPool interface {
 	DelTx(hash []byte) error
 	GetFromPending(hash []byte) (*tx.Tx, error)
 	PendingTx() (*SortedTxMap, *blockcache.BlockCacheNode)
+	AccountStats(publisher string) *AccountStats
+	Status() *Status
 
 	// TODO: The following interfaces need to be moved from txpool to chainbase.
 	AddLinkedNode(linkedNode *blockcache.BlockCacheNode) error
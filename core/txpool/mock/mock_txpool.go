@@ -37,6 +37,20 @@ func (m *MockTxPool) EXPECT() *MockTxPoolMockRecorder {
 	return m.recorder
 }
 
+// AccountStats mocks base method.
+func (m *MockTxPool) AccountStats(publisher string) *txpool.AccountStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccountStats", publisher)
+	ret0, _ := ret[0].(*txpool.AccountStats)
+	return ret0
+}
+
+// AccountStats indicates an expected call of AccountStats.
+func (mr *MockTxPoolMockRecorder) AccountStats(publisher interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountStats", reflect.TypeOf((*MockTxPool)(nil).AccountStats), publisher)
+}
+
 // AddLinkedNode mocks base method.
 func (m *MockTxPool) AddLinkedNode(linkedNode *blockcache.BlockCacheNode) error {
 	m.ctrl.T.Helper()
@@ -150,3 +164,17 @@ func (mr *MockTxPoolMockRecorder) PendingTx() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingTx", reflect.TypeOf((*MockTxPool)(nil).PendingTx))
 }
+
+// Status mocks base method.
+func (m *MockTxPool) Status() *txpool.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status")
+	ret0, _ := ret[0].(*txpool.Status)
+	return ret0
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockTxPoolMockRecorder) Status() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockTxPool)(nil).Status))
+}
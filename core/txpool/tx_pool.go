@@ -1,6 +1,7 @@
 package txpool
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sync"
@@ -9,7 +10,9 @@ import (
 	"github.com/iost-official/go-iost/v3/common"
 	"github.com/iost-official/go-iost/v3/core/block"
 	"github.com/iost-official/go-iost/v3/core/blockcache"
+	"github.com/iost-official/go-iost/v3/core/event"
 	"github.com/iost-official/go-iost/v3/core/tx"
+	"github.com/iost-official/go-iost/v3/core/version"
 	"github.com/iost-official/go-iost/v3/ilog"
 )
 
@@ -20,6 +23,7 @@ type TxPImpl struct {
 	forkChain  *forkChain
 	blockList  *sync.Map // map[string]*blockTx
 	pendingTx  *SortedTxMap
+	drops      map[DropReason]int64
 	mu         sync.RWMutex
 	quitCh     chan struct{}
 }
@@ -32,6 +36,7 @@ func NewTxPoolImpl(bChain block.Chain, blockCache blockcache.BlockCache) (*TxPIm
 		forkChain:  new(forkChain),
 		blockList:  new(sync.Map),
 		pendingTx:  NewSortedTxMap(),
+		drops:      make(map[DropReason]int64),
 		quitCh:     make(chan struct{}),
 	}
 	p.forkChain.SetNewHead(blockCache.Head())
@@ -100,11 +105,26 @@ func (pool *TxPImpl) AddTx(t *tx.Tx, from string) error {
 	pool.mu.Lock()
 	err := pool.verifyDuplicate(t)
 	if err != nil {
+		pool.drop(DropDuplicate)
 		pool.mu.Unlock()
 		return err
 	}
 	err = pool.verifyTx(t)
 	if err != nil {
+		pool.drop(DropInvalid)
+		pool.mu.Unlock()
+		return err
+	}
+	err = pool.admitTx(t)
+	if err != nil {
+		switch err {
+		case ErrPendingReplaceUnderpriced:
+			pool.drop(DropUnderpriced)
+		case ErrAccountQuota:
+			pool.drop(DropAccountQuota)
+		case ErrCacheFull:
+			pool.drop(DropFull)
+		}
 		pool.mu.Unlock()
 		return err
 	}
@@ -118,9 +138,42 @@ func (pool *TxPImpl) AddTx(t *tx.Tx, from string) error {
 	)
 
 	metricsReceivedTxCount.Add(1, map[string]string{"from": from})
+	pool.postPendingTx(t)
 	return nil
 }
 
+func (pool *TxPImpl) postPendingTx(t *tx.Tx) {
+	data, err := json.Marshal(event.NewPendingTxData(t))
+	if err != nil {
+		ilog.Errorf("Marshal PendingTx event failed: %v", err)
+		return
+	}
+	event.GetCollector().Post(event.NewEvent(event.PendingTx, string(data)), event.NewPendingTxMeta(t))
+}
+
+// drop counts the dropped tx, it should be called with pool.mu locked.
+func (pool *TxPImpl) drop(reason DropReason) {
+	pool.drops[reason]++
+	metricsDroppedTxCount.Add(1, map[string]string{"reason": string(reason)})
+}
+
+// Status returns the status of txpool.
+func (pool *TxPImpl) Status() *Status {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	drops := make(map[DropReason]int64, len(pool.drops))
+	for r, n := range pool.drops {
+		drops[r] = n
+	}
+	return &Status{
+		Size:         pool.pendingTx.Size(),
+		Capacity:     maxCacheTxs,
+		OldestTxTime: pool.pendingTx.OldestTime(),
+		Drops:        drops,
+	}
+}
+
 // DelTx del the transaction
 func (pool *TxPImpl) DelTx(hash []byte) error {
 	pool.pendingTx.Del(hash)
@@ -147,16 +200,69 @@ func (pool *TxPImpl) initBlockTx() {
 	}
 }
 
-func (pool *TxPImpl) verifyTx(t *tx.Tx) error {
-	isSimpleTransfer := len(t.Actions) == 1 && t.Actions[0].Contract == "token.iost" && len(t.Actions[0].Data) <= 70
-	if isSimpleTransfer {
-		if pool.pendingTx.Size() > maxCacheTxs {
-			return ErrCacheFull
+// AccountStats returns the pending txs of the publisher.
+func (pool *TxPImpl) AccountStats(publisher string) *AccountStats {
+	stats := &AccountStats{
+		Quota:    maxAccountTxs,
+		TxHashes: make([][]byte, 0),
+	}
+	for _, t := range pool.pendingTx.AccountTxs(publisher) {
+		if stats.Pending == 0 || t.GasRatio < stats.MinGasRatio {
+			stats.MinGasRatio = t.GasRatio
 		}
-	} else {
-		if pool.pendingTx.Size() > maxCacheTxs/10 {
-			return ErrCacheFull
+		if t.GasRatio > stats.MaxGasRatio {
+			stats.MaxGasRatio = t.GasRatio
 		}
+		stats.Pending++
+		stats.TxHashes = append(stats.TxHashes, t.Hash())
+	}
+	return stats
+}
+
+func isSimpleTransfer(t *tx.Tx) bool {
+	return len(t.Actions) == 1 && t.Actions[0].Contract == "token.iost" && len(t.Actions[0].Data) <= 70
+}
+
+// admitTx makes room for the verified tx in pending. It replaces the pending tx with the same publisher
+// and time if the gas ratio is higher, or evicts the tx of the lowest priority if the pool is full.
+// The replacement is pool-only, it doesn't cancel the replaced tx, which can still be packed by the
+// nodes which received it.
+func (pool *TxPImpl) admitTx(t *tx.Tx) error {
+	if old := pool.pendingTx.GetBySlot(t.Publisher, t.Time); old != nil {
+		if t.GasRatio <= old.GasRatio {
+			return ErrPendingReplaceUnderpriced
+		}
+		pool.pendingTx.Del(old.Hash())
+		pool.drop(DropReplacedInPending)
+		ilog.Debugf("Replaced %v by %v in pendingTx.", common.Base58Encode(old.Hash()), common.Base58Encode(t.Hash()))
+		return nil
+	}
+	if pool.pendingTx.AccountSize(t.Publisher) >= maxAccountTxs {
+		return ErrAccountQuota
+	}
+	limit := maxCacheTxs
+	if !isSimpleTransfer(t) {
+		limit = maxCacheTxs / 10
+	}
+	if pool.pendingTx.Size() <= limit {
+		return nil
+	}
+	lowest := pool.pendingTx.Lowest()
+	if lowest == nil || compareTx(t, lowest) <= 0 {
+		return ErrCacheFull
+	}
+	pool.pendingTx.Del(lowest.Hash())
+	pool.drop(DropEvicted)
+	ilog.Debugf("Evicted %v from full pendingTx.", common.Base58Encode(lowest.Hash()))
+	return nil
+}
+
+func (pool *TxPImpl) verifyTx(t *tx.Tx) error {
+	if t.IsDefer() {
+		return ErrDeferTx
+	}
+	if t.Delay > 0 && !version.IsFork3_11_0(pool.forkChain.GetNewHead().Head.Number+1) {
+		return ErrDelayTx
 	}
 	// Add one second delay for tx created time check
 	currentTime := time.Now().UnixNano()
@@ -257,6 +363,7 @@ func (pool *TxPImpl) clearTimeoutTx() {
 	for ok {
 		if t.IsExpired(time.Now().UnixNano()) {
 			pool.pendingTx.Del(t.Hash())
+			pool.drop(DropExpired)
 		}
 		t, ok = iter.Next()
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/iost-official/go-iost/v3/common"
 	"github.com/iost-official/go-iost/v3/core/block"
 	"github.com/iost-official/go-iost/v3/core/blockcache"
+	"github.com/iost-official/go-iost/v3/core/event"
 	core_mock "github.com/iost-official/go-iost/v3/core/mocks"
 	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/crypto"
@@ -205,6 +206,91 @@ func TestNewTxPImpl(t *testing.T) {
 			r1 := txPool.ExistTxs(t.Hash(), bcn.Block)
 			So(r1, ShouldEqual, false)
 		})
+		Convey("Status", func() {
+			t1 := genTxWithGasRatio(accountList[0], 100, 0)
+			So(txPool.AddTx(t1, "rpc"), ShouldBeNil)
+			So(txPool.AddTx(t1, "rpc"), ShouldEqual, ErrDupPendingTx)
+			So(txPool.AddTx(genTxWithGasRatio(accountList[0], 200, t1.Time), "rpc"), ShouldBeNil)
+
+			status := txPool.Status()
+			So(status.Size, ShouldEqual, 1)
+			So(status.Capacity, ShouldEqual, maxCacheTxs)
+			So(status.OldestTxTime, ShouldEqual, t1.Time)
+			So(status.Drops[DropDuplicate], ShouldEqual, 1)
+			So(status.Drops[DropReplacedInPending], ShouldEqual, 1)
+		})
+		Convey("PendingTx event", func() {
+			ec := event.GetCollector()
+			ch := ec.Subscribe(1, []event.Topic{event.PendingTx}, &event.Filter{Signer: accountList[1].ReadablePubkey()})
+			defer ec.Unsubscribe(1, []event.Topic{event.PendingTx})
+
+			So(txPool.AddTx(genTx(accountList[0], tx.MaxExpiration), "rpc"), ShouldBeNil)
+			t := genTx(accountList[1], tx.MaxExpiration)
+			So(txPool.AddTx(t, "rpc"), ShouldBeNil)
+			select {
+			case e := <-ch:
+				var data event.PendingTxData
+				So(json.Unmarshal([]byte(e.Data), &data), ShouldBeNil)
+				So(data.Hash, ShouldEqual, common.Base58Encode(t.Hash()))
+				So(data.Actions, ShouldHaveLength, 2)
+				So(data.Actions[0].Contract, ShouldEqual, "contract1")
+			case <-time.After(time.Second):
+				So("PendingTx event timeout", ShouldBeEmpty)
+			}
+		})
+		Convey("ReplacePendingTx", func() {
+			t1 := genTxWithGasRatio(accountList[0], 100, 0)
+			So(txPool.AddTx(t1, "rpc"), ShouldBeNil)
+			t2 := genTxWithGasRatio(accountList[0], 200, t1.Time)
+			So(txPool.AddTx(t2, "rpc"), ShouldBeNil)
+			So(txPool.testPendingTxsNum(), ShouldEqual, 1)
+			_, err := txPool.GetFromPending(t1.Hash())
+			So(err, ShouldEqual, ErrTxNotFound)
+			_, err = txPool.GetFromPending(t2.Hash())
+			So(err, ShouldBeNil)
+
+			t3 := genTxWithGasRatio(accountList[0], 150, t1.Time)
+			So(txPool.AddTx(t3, "rpc"), ShouldEqual, ErrPendingReplaceUnderpriced)
+		})
+		Convey("AccountQuota", func() {
+			defer func(n int) { maxAccountTxs = n }(maxAccountTxs)
+			maxAccountTxs = 2
+
+			t1 := genTxWithGasRatio(accountList[0], 100, 0)
+			t2 := genTxWithGasRatio(accountList[0], 300, 0)
+			So(txPool.AddTx(t1, "rpc"), ShouldBeNil)
+			So(txPool.AddTx(t2, "rpc"), ShouldBeNil)
+			So(txPool.AddTx(genTxWithGasRatio(accountList[0], 100, 0), "rpc"), ShouldEqual, ErrAccountQuota)
+			So(txPool.AddTx(genTxWithGasRatio(accountList[1], 100, 0), "rpc"), ShouldBeNil)
+
+			stats := txPool.AccountStats(accountList[0].ReadablePubkey())
+			So(stats.Pending, ShouldEqual, 2)
+			So(stats.Quota, ShouldEqual, 2)
+			So(stats.MinGasRatio, ShouldEqual, 100)
+			So(stats.MaxGasRatio, ShouldEqual, 300)
+			So(stats.TxHashes, ShouldContain, t1.Hash())
+			So(stats.TxHashes, ShouldContain, t2.Hash())
+			So(txPool.AccountStats("nobody").Pending, ShouldEqual, 0)
+		})
+		Convey("EvictTx", func() {
+			defer func(n int) { maxCacheTxs = n }(maxCacheTxs)
+			maxCacheTxs = 10 // the pool is full of 2 txs which aren't simple transfers
+
+			t1 := genTxWithGasRatio(accountList[0], 100, 0)
+			t2 := genTxWithGasRatio(accountList[1], 100, 0)
+			So(txPool.AddTx(t1, "rpc"), ShouldBeNil)
+			So(txPool.AddTx(t2, "rpc"), ShouldBeNil)
+			So(txPool.AddTx(genTxWithGasRatio(accountList[2], 100, 0), "rpc"), ShouldEqual, ErrCacheFull)
+
+			t3 := genTxWithGasRatio(accountList[2], 300, 0)
+			So(txPool.AddTx(t3, "rpc"), ShouldBeNil)
+			So(txPool.testPendingTxsNum(), ShouldEqual, 2)
+			_, err := txPool.GetFromPending(t2.Hash())
+			// the newer tx of the same gas ratio is evicted
+			So(err, ShouldEqual, ErrTxNotFound)
+			_, err = txPool.GetFromPending(t1.Hash())
+			So(err, ShouldBeNil)
+		})
 		stopTest(base, statedb)
 	})
 
@@ -657,6 +743,26 @@ func genTx(a *account.KeyPair, expirationIter int64) *tx.Tx {
 	return t1
 }
 
+// genTxWithGasRatio returns a signed tx with gasRatio, the time is set if it isn't 0.
+func genTxWithGasRatio(a *account.KeyPair, gasRatio int64, time int64) *tx.Tx {
+	t := genTx(a, tx.MaxExpiration)
+	t.GasRatio = gasRatio
+	if time != 0 {
+		t.Time = time
+	}
+	t.Signs = nil
+	sig, err := tx.SignTxContent(t, a.ReadablePubkey(), a)
+	if err != nil {
+		ilog.Debug("failed to SignTxContent")
+	}
+	t.Signs = append(t.Signs, sig)
+	t, err = tx.SignTx(t, a.ReadablePubkey(), []*account.KeyPair{a})
+	if err != nil {
+		ilog.Debug("failed to SignTx")
+	}
+	return t
+}
+
 func genTxMsg(a *account.KeyPair, expirationIter int64) *p2p.IncomingMessage {
 	t := genTx(a, expirationIter)
 
@@ -18,15 +18,22 @@ var (
 	clearInterval = 10 * time.Second
 	filterTime    = int64(90 * time.Second)
 	maxCacheTxs   = 10000
+	maxAccountTxs = 1000
 	maxTxTimeGap  = 30 * time.Second.Nanoseconds()
 
 	metricsReceivedTxCount = metrics.NewCounter("iost_tx_received_count", []string{"from"})
 	metricsTxPoolSize      = metrics.NewGauge("iost_txpool_size", nil)
+	metricsDroppedTxCount  = metrics.NewCounter("iost_txpool_dropped_count", []string{"reason"})
 
 	ErrDupPendingTx = errors.New("tx exists in pending")
 	ErrDupChainTx   = errors.New("tx exists in chain")
 	ErrCacheFull    = errors.New("txpool is full")
 	ErrTxNotFound   = errors.New("tx not found")
+	ErrDelayTx      = errors.New("delay tx is not allowed before fork 3.11.0")
+	ErrDeferTx      = errors.New("deferred tx is executed from schedule and can't be sent")
+
+	ErrAccountQuota              = errors.New("txpool quota of the publisher is exceeded")
+	ErrPendingReplaceUnderpriced = errors.New("tx with the same publisher and time is pending with a higher or equal gas ratio")
 )
 
 // FRet find the return value of the tx
@@ -136,11 +143,57 @@ func (b *blockTx) getTxAndReceipt(hash []byte) (*tx.Tx, *tx.TxReceipt) {
 	return retTx, nil
 }
 
+// DropReason is the reason why a tx is rejected or removed from pending.
+type DropReason string
+
+// drop reasons
+const (
+	DropDuplicate         DropReason = "duplicate"
+	DropInvalid           DropReason = "invalid"
+	DropFull              DropReason = "full"
+	DropAccountQuota      DropReason = "account_quota"
+	DropUnderpriced       DropReason = "underpriced"
+	DropReplacedInPending DropReason = "replaced_in_pending"
+	DropEvicted           DropReason = "evicted"
+	DropExpired           DropReason = "expired"
+)
+
+// Status is the status of txpool.
+type Status struct {
+	Size     int
+	Capacity int
+	// OldestTxTime is the time of the oldest pending tx, 0 if there is no pending tx.
+	OldestTxTime int64
+	// Drops is the count of dropped txs of each reason since start.
+	Drops map[DropReason]int64
+}
+
+// AccountStats is the pending txs of a publisher in txpool.
+type AccountStats struct {
+	Pending     int
+	Quota       int
+	MinGasRatio int64
+	MaxGasRatio int64
+	TxHashes    [][]byte
+}
+
+// pendingSlot is the slot of a tx in pending. A pending tx is replaced by a tx in the same slot,
+// which has the same publisher and time, and a higher gas ratio. The replacement is only a
+// preference of the local pool and isn't enforced by block verification, the replaced tx is
+// still valid in blocks if other nodes have it.
+type pendingSlot struct {
+	publisher string
+	time      int64
+}
+
 // SortedTxMap is a red black tree of tx.
 type SortedTxMap struct {
-	tree  *redblacktree.Tree
-	txMap map[string]*tx.Tx
-	rw    *sync.RWMutex
+	tree       *redblacktree.Tree
+	txMap      map[string]*tx.Tx
+	slotMap    map[pendingSlot]*tx.Tx
+	shortIDMap map[uint64]*tx.Tx
+	accountMap map[string]map[string]*tx.Tx
+	rw         *sync.RWMutex
 }
 
 func compareTx(a, b any) int {
@@ -174,9 +227,12 @@ func compareTx(a, b any) int {
 // NewSortedTxMap returns a new SortedTxMap instance.
 func NewSortedTxMap() *SortedTxMap {
 	return &SortedTxMap{
-		tree:  redblacktree.NewWith(compareTx),
-		txMap: make(map[string]*tx.Tx),
-		rw:    new(sync.RWMutex),
+		tree:       redblacktree.NewWith(compareTx),
+		txMap:      make(map[string]*tx.Tx),
+		slotMap:    make(map[pendingSlot]*tx.Tx),
+		shortIDMap: make(map[uint64]*tx.Tx),
+		accountMap: make(map[string]map[string]*tx.Tx),
+		rw:         new(sync.RWMutex),
 	}
 }
 
@@ -188,11 +244,24 @@ func (st *SortedTxMap) Get(hash []byte) *tx.Tx {
 }
 
 // Add adds a tx in SortedTxMap.
-func (st *SortedTxMap) Add(tx *tx.Tx) {
+func (st *SortedTxMap) Add(t *tx.Tx) {
 	st.rw.Lock()
-	st.tree.Put(tx, true)
-	st.txMap[string(tx.Hash())] = tx
-	st.rw.Unlock()
+	defer st.rw.Unlock()
+
+	hash := string(t.Hash())
+	if _, ok := st.txMap[hash]; ok {
+		return
+	}
+	st.tree.Put(t, true)
+	st.txMap[hash] = t
+	st.slotMap[pendingSlot{t.Publisher, t.Time}] = t
+	st.shortIDMap[t.ShortID()] = t
+	txs := st.accountMap[t.Publisher]
+	if txs == nil {
+		txs = make(map[string]*tx.Tx)
+		st.accountMap[t.Publisher] = txs
+	}
+	txs[hash] = t
 }
 
 // Del deletes a tx in SortedTxMap.
@@ -200,12 +269,83 @@ func (st *SortedTxMap) Del(hash []byte) {
 	st.rw.Lock()
 	defer st.rw.Unlock()
 
-	tx := st.txMap[string(hash)]
-	if tx == nil {
+	t := st.txMap[string(hash)]
+	if t == nil {
 		return
 	}
-	st.tree.Remove(tx)
+	st.tree.Remove(t)
 	delete(st.txMap, string(hash))
+	slot := pendingSlot{t.Publisher, t.Time}
+	if st.slotMap[slot] == t {
+		delete(st.slotMap, slot)
+	}
+	if st.shortIDMap[t.ShortID()] == t {
+		delete(st.shortIDMap, t.ShortID())
+	}
+	txs := st.accountMap[t.Publisher]
+	delete(txs, string(hash))
+	if len(txs) == 0 {
+		delete(st.accountMap, t.Publisher)
+	}
+}
+
+// GetBySlot returns the pending tx in the slot of publisher and time.
+func (st *SortedTxMap) GetBySlot(publisher string, time int64) *tx.Tx {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+	return st.slotMap[pendingSlot{publisher, time}]
+}
+
+// GetByShortID returns the pending tx of short id.
+func (st *SortedTxMap) GetByShortID(id uint64) *tx.Tx {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+	return st.shortIDMap[id]
+}
+
+// AccountTxs returns the txs of publisher.
+func (st *SortedTxMap) AccountTxs(publisher string) []*tx.Tx {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+
+	txs := make([]*tx.Tx, 0, len(st.accountMap[publisher]))
+	for _, t := range st.accountMap[publisher] {
+		txs = append(txs, t)
+	}
+	return txs
+}
+
+// AccountSize returns the number of txs of publisher.
+func (st *SortedTxMap) AccountSize(publisher string) int {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+	return len(st.accountMap[publisher])
+}
+
+// OldestTime returns the earliest time of txs, 0 if it is empty.
+func (st *SortedTxMap) OldestTime() int64 {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+
+	var oldest int64
+	for _, t := range st.txMap {
+		if oldest == 0 || t.Time < oldest {
+			oldest = t.Time
+		}
+	}
+	return oldest
+}
+
+// Lowest returns the tx with the lowest priority, which is the first to be evicted.
+func (st *SortedTxMap) Lowest() *tx.Tx {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+
+	node := st.tree.Left()
+	if node == nil {
+		return nil
+	}
+	return node.Key.(*tx.Tx)
 }
 
 // Size returns the size of SortedTxMap.
@@ -1,6 +1,10 @@
 package version
 
-import "github.com/iost-official/go-iost/v3/common"
+import (
+	"math"
+
+	"github.com/iost-official/go-iost/v3/common"
+)
 
 // ChainIDs
 const (
@@ -12,22 +16,39 @@ const (
 type ChainConfig struct {
 	Block3_9_0  int64
 	Block3_10_0 int64
+	Block3_11_0 int64
+	Block3_12_0 int64
+	Block3_13_0 int64
+	Block3_14_0 int64
 }
 
 var (
 	mainNetChainConf = &ChainConfig{
 		Block3_9_0:  220000000,
 		Block3_10_0: 520000000,
+		// not scheduled yet
+		Block3_11_0: math.MaxInt64,
+		Block3_12_0: math.MaxInt64,
+		Block3_13_0: math.MaxInt64,
+		Block3_14_0: math.MaxInt64,
 	}
 
 	testNetChainConf = &ChainConfig{
 		Block3_9_0:  0,
 		Block3_10_0: 0,
+		Block3_11_0: 0,
+		Block3_12_0: 0,
+		Block3_13_0: 0,
+		Block3_14_0: 0,
 	}
 
 	defaultChainConf = &ChainConfig{
 		Block3_9_0:  0,
 		Block3_10_0: 0,
+		Block3_11_0: 0,
+		Block3_12_0: 0,
+		Block3_13_0: 0,
+		Block3_14_0: 0,
 	}
 )
 
@@ -55,6 +76,26 @@ func IsFork3_10_0(num int64) bool {
 	return isForked(chainConf.Block3_10_0, num)
 }
 
+// IsFork3_11_0 ...
+func IsFork3_11_0(num int64) bool {
+	return isForked(chainConf.Block3_11_0, num)
+}
+
+// IsFork3_12_0 ...
+func IsFork3_12_0(num int64) bool {
+	return isForked(chainConf.Block3_12_0, num)
+}
+
+// IsFork3_13_0 ...
+func IsFork3_13_0(num int64) bool {
+	return isForked(chainConf.Block3_13_0, num)
+}
+
+// IsFork3_14_0 ...
+func IsFork3_14_0(num int64) bool {
+	return isForked(chainConf.Block3_14_0, num)
+}
+
 func isForked(v, num int64) bool {
 	return v <= num
 }
@@ -63,6 +104,14 @@ func isForked(v, num int64) bool {
 type Rules struct {
 	IsFork3_9_0  bool `json:"is_fork3_9_0"`
 	IsFork3_10_0 bool `json:"is_fork3_10_0"`
+	// delay txs are executed after fork 3.11.0
+	IsFork3_11_0 bool `json:"is_fork3_11_0"`
+	// block limits in host settings take effect after fork 3.12.0
+	IsFork3_12_0 bool `json:"is_fork3_12_0"`
+	// wasm contracts can be deployed and called after fork 3.13.0
+	IsFork3_13_0 bool `json:"is_fork3_13_0"`
+	// contract versions are recorded and migrate is called on update after fork 3.14.0
+	IsFork3_14_0 bool `json:"is_fork3_14_0"`
 }
 
 // NewRules create Rules for each block
@@ -70,5 +119,9 @@ func NewRules(num int64) *Rules {
 	return &Rules{
 		IsFork3_9_0:  IsFork3_9_0(num),
 		IsFork3_10_0: IsFork3_10_0(num),
+		IsFork3_11_0: IsFork3_11_0(num),
+		IsFork3_12_0: IsFork3_12_0(num),
+		IsFork3_13_0: IsFork3_13_0(num),
+		IsFork3_14_0: IsFork3_14_0(num),
 	}
 }
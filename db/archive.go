@@ -0,0 +1,333 @@
+package db
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/iost-official/go-iost/v3/db/kv"
+	"github.com/iost-official/go-iost/v3/db/mvcc"
+)
+
+// Archive mode keeps the history state of flushed tags. Each Flush gets an increasing version,
+// and every key changed by the flush saves its previous value under that version. So the value
+// of a key at version v is the previous value saved by the first later version, or the current
+// value if it hasn't been changed since v.
+//
+// The history is saved in the same storage with keys that can't conflict with tables:
+//
+//	/archive/h/ | uvarint len | table/key | version  ->  previous value
+//	/archive/i/ | version | uvarint len | table/key  ->  (index for pruning)
+//	/archive/t/ | tag  ->  version
+//	/archive/n/ | version  ->  tag
+var (
+	archiveHistoryPrefix = []byte(string(SEPARATOR) + "archive/h/")
+	archiveIndexPrefix   = []byte(string(SEPARATOR) + "archive/i/")
+	archiveTagPrefix     = []byte(string(SEPARATOR) + "archive/t/")
+	archiveNumberPrefix  = []byte(string(SEPARATOR) + "archive/n/")
+	archiveVersionKey    = []byte(string(SEPARATOR) + "archive/version")
+	archiveMinVersionKey = []byte(string(SEPARATOR) + "archive/min")
+)
+
+// error of archive
+var (
+	ErrArchiveDisabled  = errors.New("archive mode is disabled")
+	ErrStateNotArchived = errors.New("state of the tag isn't archived or has been pruned")
+	ErrArchiveReadOnly  = errors.New("archived state is read only")
+)
+
+// Archive is the mvccdb that can return the state of a flushed tag.
+type Archive interface {
+	StateAt(t string) (MVCCDB, error)
+}
+
+type archive struct {
+	// keep is the number of recent versions to keep, 0 keeps all
+	keep int64
+	// version is the version of the last flush, minVersion is the earliest version that can be queried
+	version    int64
+	minVersion int64
+	// flush holds the write lock while the storage and the history are changing
+	rwmu sync.RWMutex
+}
+
+// NewArchiveMVCCDB returns new mvccdb in archive mode, which keeps the state of recent keep flushed tags.
+// It keeps all history if keep is 0.
+func NewArchiveMVCCDB(path string, storageType kv.StorageType, keep int64) (MVCCDB, error) {
+	if keep < 0 {
+		return nil, fmt.Errorf("invalid archive keep: %v", keep)
+	}
+	mvccdb, err := NewCacheMVCCDB(path, storageType, mvcc.MapCache)
+	if err != nil {
+		return nil, err
+	}
+	a := &archive{keep: keep}
+	if a.version, err = getVersion(mvccdb.storage, archiveVersionKey); err != nil {
+		return nil, err
+	}
+	if a.minVersion, err = getVersion(mvccdb.storage, archiveMinVersionKey); err != nil {
+		return nil, err
+	}
+	mvccdb.archive = a
+	return mvccdb, nil
+}
+
+func versionBytes(v int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(v))
+	return b
+}
+
+func getVersion(storage *kv.Storage, key []byte) (int64, error) {
+	b, err := storage.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	if len(b) == 0 {
+		return 0, nil
+	}
+	if len(b) != 8 {
+		return 0, fmt.Errorf("invalid archive version of %s", key)
+	}
+	return int64(binary.BigEndian.Uint64(b)), nil
+}
+
+func historyPrefix(k []byte) []byte {
+	p := make([]byte, 0, len(archiveHistoryPrefix)+binary.MaxVarintLen64+len(k))
+	p = append(p, archiveHistoryPrefix...)
+	p = binary.AppendUvarint(p, uint64(len(k)))
+	return append(p, k...)
+}
+
+func indexKey(v int64, k []byte) []byte {
+	p := make([]byte, 0, len(archiveIndexPrefix)+8+binary.MaxVarintLen64+len(k))
+	p = append(p, archiveIndexPrefix...)
+	p = append(p, versionBytes(v)...)
+	p = binary.AppendUvarint(p, uint64(len(k)))
+	return append(p, k...)
+}
+
+// record saves the value of k before it is changed by the flush of version.
+// The previous value is encoded with a leading byte of whether it exists.
+func (a *archive) record(storage *kv.Storage, version int64, k []byte, item *Item) error {
+	exist, err := storage.Has(k)
+	if err != nil {
+		return err
+	}
+	prev, err := storage.Get(k)
+	if err != nil {
+		return err
+	}
+	if exist == !item.deleted && (!exist || string(prev) == item.value) {
+		return nil
+	}
+	v := []byte{0}
+	if exist {
+		v = append([]byte{1}, prev...)
+	}
+	if err := storage.Put(append(historyPrefix(k), versionBytes(version)...), v); err != nil {
+		return err
+	}
+	return storage.Put(indexKey(version, k), []byte{})
+}
+
+// finish saves the version of tag in the batch of flush.
+func (a *archive) finish(storage *kv.Storage, version int64, t string) error {
+	if err := storage.Put(append(append([]byte{}, archiveTagPrefix...), t...), versionBytes(version)); err != nil {
+		return err
+	}
+	if err := storage.Put(append(append([]byte{}, archiveNumberPrefix...), versionBytes(version)...), []byte(t)); err != nil {
+		return err
+	}
+	return storage.Put(archiveVersionKey, versionBytes(version))
+}
+
+// pruneTo prunes the history out of keep after the flush of version is committed, it returns the new min version.
+// It runs in its own batch so that the range queries see the history written by the flush.
+func (a *archive) pruneTo(storage *kv.Storage, version int64) (int64, error) {
+	minVersion := a.minVersion
+	if minVersion == 0 {
+		minVersion = version
+	}
+	if err := storage.BeginBatch(); err != nil {
+		return 0, err
+	}
+	if a.keep > 0 && version-a.keep+1 > minVersion {
+		// history of versions not greater than newMin isn't needed by queries at newMin or later
+		newMin := version - a.keep + 1
+		for v := minVersion; v <= newMin; v++ {
+			if err := a.prune(storage, v, v < newMin); err != nil {
+				return 0, err
+			}
+		}
+		minVersion = newMin
+	}
+	if err := storage.Put(archiveMinVersionKey, versionBytes(minVersion)); err != nil {
+		return 0, err
+	}
+	return minVersion, storage.CommitBatch()
+}
+
+func (a *archive) prune(storage *kv.Storage, v int64, dropTag bool) error {
+	from := append(append([]byte{}, archiveIndexPrefix...), versionBytes(v)...)
+	to := append(append([]byte{}, archiveIndexPrefix...), versionBytes(v+1)...)
+	keys, err := storage.KeysByRange(from, to, 0)
+	if err != nil {
+		return err
+	}
+	for _, ik := range keys {
+		l, n := binary.Uvarint(ik[len(from):])
+		if n <= 0 || len(ik) != len(from)+n+int(l) {
+			return fmt.Errorf("invalid archive index key")
+		}
+		k := ik[len(from)+n:]
+		if err := storage.Delete(append(historyPrefix(k), versionBytes(v)...)); err != nil {
+			return err
+		}
+		if err := storage.Delete(ik); err != nil {
+			return err
+		}
+	}
+	if !dropTag {
+		return nil
+	}
+	nk := append(append([]byte{}, archiveNumberPrefix...), versionBytes(v)...)
+	t, err := storage.Get(nk)
+	if err != nil {
+		return err
+	}
+	if err := storage.Delete(append(append([]byte{}, archiveTagPrefix...), t...)); err != nil {
+		return err
+	}
+	return storage.Delete(nk)
+}
+
+// StateAt returns the read only state of the flushed tag in archive mode.
+func (m *CacheMVCCDB) StateAt(t string) (MVCCDB, error) {
+	if m.archive == nil {
+		return nil, ErrArchiveDisabled
+	}
+	m.archive.rwmu.RLock()
+	defer m.archive.rwmu.RUnlock()
+
+	version, err := getVersion(m.storage, append(append([]byte{}, archiveTagPrefix...), t...))
+	if err != nil {
+		return nil, err
+	}
+	if version == 0 || version < m.archive.minVersion {
+		return nil, ErrStateNotArchived
+	}
+	return &archiveMVCCDB{
+		tag:     t,
+		version: version,
+		archive: m.archive,
+		storage: m.storage,
+	}, nil
+}
+
+// archiveMVCCDB is the read only state at a version of archive.
+type archiveMVCCDB struct {
+	tag     string
+	version int64
+	archive *archive
+	storage *kv.Storage
+}
+
+func (m *archiveMVCCDB) get(table string, key string) (string, bool, error) {
+	if table == "" || strings.ContainsRune(table, SEPARATOR) {
+		return "", false, ErrTableNotValid
+	}
+	m.archive.rwmu.RLock()
+	defer m.archive.rwmu.RUnlock()
+
+	if m.version < m.archive.minVersion {
+		return "", false, ErrStateNotArchived
+	}
+	k := []byte(table + string(SEPARATOR) + key)
+	p := historyPrefix(k)
+	from := append(append([]byte{}, p...), versionBytes(m.version+1)...)
+	to := append(append([]byte{}, p...), versionBytes(math.MaxInt64)...)
+	keys, err := m.storage.KeysByRange(from, to, 1)
+	if err != nil {
+		return "", false, err
+	}
+	if len(keys) == 0 {
+		exist, err := m.storage.Has(k)
+		if err != nil || !exist {
+			return "", false, err
+		}
+		v, err := m.storage.Get(k)
+		return string(v), true, err
+	}
+	v, err := m.storage.Get(keys[0])
+	if err != nil {
+		return "", false, err
+	}
+	if len(v) == 0 || v[0] == 0 {
+		return "", false, nil
+	}
+	return string(v[1:]), true, nil
+}
+
+// Get returns the value of specify key and table at the version
+func (m *archiveMVCCDB) Get(table string, key string) (string, error) {
+	v, _, err := m.get(table, key)
+	return v, err
+}
+
+// Has returns whether the specified key exists in the table at the version
+func (m *archiveMVCCDB) Has(table string, key string) (bool, error) {
+	_, exist, err := m.get(table, key)
+	return exist, err
+}
+
+// Put isn't supported
+func (m *archiveMVCCDB) Put(table string, key string, value string) error {
+	return ErrArchiveReadOnly
+}
+
+// Del isn't supported
+func (m *archiveMVCCDB) Del(table string, key string) error {
+	return ErrArchiveReadOnly
+}
+
+// KeysByRange isn't supported, the history isn't indexed by key range
+func (m *archiveMVCCDB) KeysByRange(table string, from string, to string, limit int) ([]string, error) {
+	return nil, errors.New("keys by range isn't supported in archived state")
+}
+
+// Checkout returns whether the tag is the tag of archived state
+func (m *archiveMVCCDB) Checkout(t string) bool {
+	return t == m.tag
+}
+
+// Commit does nothing as archived state is read only
+func (m *archiveMVCCDB) Commit(t string) {}
+
+// CurrentTag returns the tag of archived state
+func (m *archiveMVCCDB) CurrentTag() string {
+	return m.tag
+}
+
+// Fork returns itself as archived state is read only
+func (m *archiveMVCCDB) Fork() MVCCDB {
+	return m
+}
+
+// Flush isn't supported
+func (m *archiveMVCCDB) Flush(t string) error {
+	return ErrArchiveReadOnly
+}
+
+// Size returns the size of the storage
+func (m *archiveMVCCDB) Size() (int64, error) {
+	return m.storage.Size()
+}
+
+// Close does nothing as the storage belongs to the mvccdb
+func (m *archiveMVCCDB) Close() error {
+	return nil
+}
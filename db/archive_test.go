@@ -0,0 +1,118 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/v3/db/kv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeArchiveHistory(t *testing.T, mvccdb MVCCDB) {
+	steps := []func(){
+		func() { mvccdb.Put("table01", "a", "1") },
+		func() {
+			mvccdb.Put("table01", "a", "2")
+			mvccdb.Put("table01", "b", "x")
+		},
+		func() { mvccdb.Del("table01", "a") },
+		func() { mvccdb.Put("table01", "a", "3") },
+	}
+	for i, step := range steps {
+		tag := []string{"t1", "t2", "t3", "t4"}[i]
+		step()
+		mvccdb.Commit(tag)
+		require.Nil(t, mvccdb.Flush(tag))
+	}
+}
+
+func checkArchiveState(t *testing.T, mvccdb MVCCDB, tag string, a string, hasA bool, b string) {
+	state, err := mvccdb.(Archive).StateAt(tag)
+	require.Nil(t, err, tag)
+	v, err := state.Get("table01", "a")
+	assert.Nil(t, err)
+	assert.Equal(t, a, v, tag)
+	ok, err := state.Has("table01", "a")
+	assert.Nil(t, err)
+	assert.Equal(t, hasA, ok, tag)
+	v, err = state.Get("table01", "b")
+	assert.Nil(t, err)
+	assert.Equal(t, b, v, tag)
+}
+
+func TestArchive(t *testing.T) {
+	path := "archive_test"
+	mvccdb, err := NewArchiveMVCCDB(path, kv.MemoryStorage, 0)
+	require.Nil(t, err)
+	defer kv.RemoveStorage(path, kv.MemoryStorage)
+
+	writeArchiveHistory(t, mvccdb)
+	checkArchiveState(t, mvccdb, "t1", "1", true, "")
+	checkArchiveState(t, mvccdb, "t2", "2", true, "x")
+	checkArchiveState(t, mvccdb, "t3", "", false, "x")
+	checkArchiveState(t, mvccdb, "t4", "3", true, "x")
+
+	_, err = mvccdb.(Archive).StateAt("t0")
+	assert.Equal(t, ErrStateNotArchived, err)
+	state, _ := mvccdb.(Archive).StateAt("t1")
+	assert.Equal(t, ErrArchiveReadOnly, state.Put("table01", "a", "4"))
+
+	// history is kept after reopen
+	require.Nil(t, mvccdb.Close())
+	mvccdb, err = NewArchiveMVCCDB(path, kv.MemoryStorage, 0)
+	require.Nil(t, err)
+	checkArchiveState(t, mvccdb, "t2", "2", true, "x")
+	v, err := mvccdb.Get("table01", "a")
+	assert.Nil(t, err)
+	assert.Equal(t, "3", v)
+}
+
+func TestArchivePrune(t *testing.T) {
+	path := "archive_prune_test"
+	mvccdb, err := NewArchiveMVCCDB(path, kv.MemoryStorage, 2)
+	require.Nil(t, err)
+	defer kv.RemoveStorage(path, kv.MemoryStorage)
+
+	writeArchiveHistory(t, mvccdb)
+	for _, tag := range []string{"t1", "t2"} {
+		_, err = mvccdb.(Archive).StateAt(tag)
+		assert.Equal(t, ErrStateNotArchived, err, tag)
+	}
+	checkArchiveState(t, mvccdb, "t3", "", false, "x")
+	checkArchiveState(t, mvccdb, "t4", "3", true, "x")
+
+	keys, err := mvccdb.(*CacheMVCCDB).storage.Keys(archiveHistoryPrefix)
+	assert.Nil(t, err)
+	assert.Len(t, keys, 1, "only the history of t4 is needed")
+
+	plain, err := NewMVCCDBWithStorage("plain_test", kv.MemoryStorage)
+	require.Nil(t, err)
+	defer kv.RemoveStorage("plain_test", kv.MemoryStorage)
+	_, err = plain.(Archive).StateAt("t1")
+	assert.Equal(t, ErrArchiveDisabled, err)
+}
+
+func TestArchiveKeepOne(t *testing.T) {
+	path := "archive_keep_one_test"
+	mvccdb, err := NewArchiveMVCCDB(path, kv.MemoryStorage, 1)
+	require.Nil(t, err)
+	defer kv.RemoveStorage(path, kv.MemoryStorage)
+
+	writeArchiveHistory(t, mvccdb)
+	for _, tag := range []string{"t1", "t2", "t3"} {
+		_, err = mvccdb.(Archive).StateAt(tag)
+		assert.Equal(t, ErrStateNotArchived, err, tag)
+	}
+	checkArchiveState(t, mvccdb, "t4", "3", true, "x")
+
+	storage := mvccdb.(*CacheMVCCDB).storage
+	keys, err := storage.Keys(archiveHistoryPrefix)
+	assert.Nil(t, err)
+	assert.Empty(t, keys, "no history is needed by the latest state")
+	keys, err = storage.Keys(archiveIndexPrefix)
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+	keys, err = storage.Keys(archiveTagPrefix)
+	assert.Nil(t, err)
+	assert.Len(t, keys, 1)
+}
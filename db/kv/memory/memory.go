@@ -0,0 +1,284 @@
+package memory
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/emirpasic/gods/trees/redblacktree"
+)
+
+var (
+	storesMu sync.Mutex
+	stores   = make(map[string]*store)
+)
+
+type store struct {
+	mu   sync.RWMutex
+	tree *redblacktree.Tree
+	size int64
+}
+
+func newStore() *store {
+	return &store{
+		tree: redblacktree.NewWith(func(a, b any) int {
+			return bytes.Compare(a.([]byte), b.([]byte))
+		}),
+	}
+}
+
+func (s *store) put(key []byte, value []byte) {
+	if old, found := s.tree.Get(key); found {
+		s.size -= int64(len(key) + len(old.([]byte)))
+	}
+	k := append([]byte{}, key...)
+	v := append([]byte{}, value...)
+	s.tree.Put(k, v)
+	s.size += int64(len(k) + len(v))
+}
+
+func (s *store) delete(key []byte) {
+	if old, found := s.tree.Get(key); found {
+		s.size -= int64(len(key) + len(old.([]byte)))
+		s.tree.Remove(key)
+	}
+}
+
+// walk calls f on every item in [from, to) in key order until f returns false.
+// An empty to means no upper bound.
+func (s *store) walk(from []byte, to []byte, f func(key, value []byte) bool) {
+	node, found := s.tree.Ceiling(from)
+	if !found {
+		return
+	}
+	it := s.tree.IteratorAt(node)
+	for ok := true; ok; ok = it.Next() {
+		key := it.Key().([]byte)
+		if len(to) > 0 && bytes.Compare(key, to) >= 0 {
+			return
+		}
+		if !f(key, it.Value().([]byte)) {
+			return
+		}
+	}
+}
+
+type op struct {
+	key    []byte
+	value  []byte
+	delete bool
+}
+
+// DB is the in-memory database. The data of a path is kept in the process
+// after Close, until Remove is called, so that it behaves like an on-disk database on reopen.
+type DB struct {
+	path  string
+	s     *store
+	batch []op
+}
+
+// NewDB return new in-memory database of the path
+func NewDB(path string) (*DB, error) {
+	storesMu.Lock()
+	defer storesMu.Unlock()
+
+	s, ok := stores[path]
+	if !ok {
+		s = newStore()
+		stores[path] = s
+	}
+	return &DB{
+		path:  path,
+		s:     s,
+		batch: nil,
+	}, nil
+}
+
+// Remove drops the data of the path
+func Remove(path string) {
+	storesMu.Lock()
+	defer storesMu.Unlock()
+
+	delete(stores, path)
+}
+
+// Get return the value of the specify key
+func (d *DB) Get(key []byte) ([]byte, error) {
+	d.s.mu.RLock()
+	defer d.s.mu.RUnlock()
+
+	value, found := d.s.tree.Get(key)
+	if !found {
+		return []byte{}, nil
+	}
+	return append([]byte{}, value.([]byte)...), nil
+}
+
+// Has returns whether the specified key exists
+func (d *DB) Has(key []byte) (bool, error) {
+	d.s.mu.RLock()
+	defer d.s.mu.RUnlock()
+
+	_, found := d.s.tree.Get(key)
+	return found, nil
+}
+
+// Put will insert the key-value pair
+func (d *DB) Put(key []byte, value []byte) error {
+	if d.batch != nil {
+		d.batch = append(d.batch, op{key: append([]byte{}, key...), value: append([]byte{}, value...)})
+		return nil
+	}
+	d.s.mu.Lock()
+	defer d.s.mu.Unlock()
+
+	d.s.put(key, value)
+	return nil
+}
+
+// Delete will remove the specify key
+func (d *DB) Delete(key []byte) error {
+	if d.batch != nil {
+		d.batch = append(d.batch, op{key: append([]byte{}, key...), delete: true})
+		return nil
+	}
+	d.s.mu.Lock()
+	defer d.s.mu.Unlock()
+
+	d.s.delete(key)
+	return nil
+}
+
+// Keys returns the list of key prefixed with prefix
+func (d *DB) Keys(prefix []byte) ([][]byte, error) {
+	d.s.mu.RLock()
+	defer d.s.mu.RUnlock()
+
+	keys := make([][]byte, 0)
+	d.s.walk(prefix, nil, func(key, value []byte) bool {
+		if !bytes.HasPrefix(key, prefix) {
+			return false
+		}
+		keys = append(keys, append([]byte{}, key...))
+		return true
+	})
+	return keys, nil
+}
+
+// KeysByRange returns the list of key in [from, to)
+func (d *DB) KeysByRange(from []byte, to []byte, limit int) ([][]byte, error) {
+	d.s.mu.RLock()
+	defer d.s.mu.RUnlock()
+
+	keys := make([][]byte, 0)
+	d.s.walk(from, to, func(key, value []byte) bool {
+		keys = append(keys, append([]byte{}, key...))
+		return limit <= 0 || len(keys) < limit
+	})
+	return keys, nil
+}
+
+// BeginBatch will start the batch transaction
+func (d *DB) BeginBatch() error {
+	if d.batch != nil {
+		return fmt.Errorf("not support nested batch write")
+	}
+	d.batch = make([]op, 0)
+	return nil
+}
+
+// CommitBatch will commit the batch transaction
+func (d *DB) CommitBatch() error {
+	if d.batch == nil {
+		return fmt.Errorf("no batch write to commit")
+	}
+	d.s.mu.Lock()
+	defer d.s.mu.Unlock()
+
+	for _, o := range d.batch {
+		if o.delete {
+			d.s.delete(o.key)
+		} else {
+			d.s.put(o.key, o.value)
+		}
+	}
+	d.batch = nil
+	return nil
+}
+
+// Size returns the total size of keys and values
+func (d *DB) Size() (int64, error) {
+	d.s.mu.RLock()
+	defer d.s.mu.RUnlock()
+
+	return d.s.size, nil
+}
+
+// Close will close the database, the uncommitted batch is dropped
+func (d *DB) Close() error {
+	d.batch = nil
+	return nil
+}
+
+// NewIteratorByPrefix returns a new iterator by prefix
+func (d *DB) NewIteratorByPrefix(prefix []byte) any {
+	d.s.mu.RLock()
+	defer d.s.mu.RUnlock()
+
+	iter := &Iter{
+		index: -1,
+	}
+	d.s.walk(prefix, nil, func(key, value []byte) bool {
+		if !bytes.HasPrefix(key, prefix) {
+			return false
+		}
+		iter.keys = append(iter.keys, key)
+		iter.values = append(iter.values, value)
+		return true
+	})
+	return iter
+}
+
+// Iter is the iterator for in-memory database, it iterates over a snapshot taken on creation
+type Iter struct {
+	keys   [][]byte
+	values [][]byte
+	index  int
+}
+
+// Next do next item of iterator
+func (i *Iter) Next() bool {
+	if i.index+1 >= len(i.keys) {
+		i.index = len(i.keys)
+		return false
+	}
+	i.index++
+	return true
+}
+
+// Key returns the key of current item
+func (i *Iter) Key() []byte {
+	if i.index < 0 || i.index >= len(i.keys) {
+		return nil
+	}
+	return i.keys[i.index]
+}
+
+// Value returns the value of current item
+func (i *Iter) Value() []byte {
+	if i.index < 0 || i.index >= len(i.keys) {
+		return nil
+	}
+	return i.values[i.index]
+}
+
+// Error returns the error of iterator
+func (i *Iter) Error() error {
+	return nil
+}
+
+// Release will release the iterator
+func (i *Iter) Release() {
+	i.keys = nil
+	i.values = nil
+}
@@ -0,0 +1,221 @@
+package pebble
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/pebble"
+)
+
+// DB is the pebble database
+type DB struct {
+	db    *pebble.DB
+	batch *pebble.Batch
+}
+
+// NewDB return new pebble db
+func NewDB(path string) (*DB, error) {
+	db, err := pebble.Open(path, &pebble.Options{})
+	if err != nil {
+		return nil, err
+	}
+	return &DB{
+		db:    db,
+		batch: nil,
+	}, nil
+}
+
+// Get return the value of the specify key
+func (d *DB) Get(key []byte) ([]byte, error) {
+	value, closer, err := d.db.Get(key)
+	if err == pebble.ErrNotFound {
+		return []byte{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	v := make([]byte, len(value))
+	copy(v, value)
+	return v, nil
+}
+
+// Has returns whether the specified key exists
+func (d *DB) Has(key []byte) (bool, error) {
+	_, closer, err := d.db.Get(key)
+	if err == pebble.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	closer.Close()
+	return true, nil
+}
+
+// Put will insert the key-value pair, it doesn't wait for fsync like the leveldb backend
+func (d *DB) Put(key []byte, value []byte) error {
+	if d.batch == nil {
+		return d.db.Set(key, value, pebble.NoSync)
+	}
+	return d.batch.Set(key, value, nil)
+}
+
+// Delete will remove the specify key
+func (d *DB) Delete(key []byte) error {
+	if d.batch == nil {
+		return d.db.Delete(key, pebble.NoSync)
+	}
+	return d.batch.Delete(key, nil)
+}
+
+// Keys returns the list of key prefixed with prefix
+func (d *DB) Keys(prefix []byte) ([][]byte, error) {
+	return d.keysByRange(prefixRange(prefix), 0)
+}
+
+// KeysByRange returns the list of key in [from, to)
+func (d *DB) KeysByRange(from []byte, to []byte, limit int) ([][]byte, error) {
+	return d.keysByRange(&pebble.IterOptions{LowerBound: from, UpperBound: to}, limit)
+}
+
+func (d *DB) keysByRange(opts *pebble.IterOptions, limit int) ([][]byte, error) {
+	iter, err := d.db.NewIter(opts)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([][]byte, 0)
+	for valid := iter.First(); valid; valid = iter.Next() {
+		key := make([]byte, len(iter.Key()))
+		copy(key, iter.Key())
+		keys = append(keys, key)
+		if limit > 0 && len(keys) >= limit {
+			break
+		}
+	}
+	if err := iter.Error(); err != nil {
+		iter.Close()
+		return nil, err
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// BeginBatch will start the batch transaction
+func (d *DB) BeginBatch() error {
+	if d.batch != nil {
+		return fmt.Errorf("not support nested batch write")
+	}
+	d.batch = d.db.NewBatch()
+	return nil
+}
+
+// CommitBatch will commit the batch transaction
+func (d *DB) CommitBatch() error {
+	if d.batch == nil {
+		return fmt.Errorf("no batch write to commit")
+	}
+	err := d.batch.Commit(pebble.NoSync)
+	if err != nil {
+		return err
+	}
+	d.batch.Close()
+	d.batch = nil
+	return nil
+}
+
+// Size returns the disk usage of pebble db
+func (d *DB) Size() (int64, error) {
+	return int64(d.db.Metrics().DiskSpaceUsage()), nil
+}
+
+// Close will close the database
+func (d *DB) Close() error {
+	if d.batch != nil {
+		d.batch.Close()
+		d.batch = nil
+	}
+	return d.db.Close()
+}
+
+// NewIteratorByPrefix returns a new iterator by prefix
+func (d *DB) NewIteratorByPrefix(prefix []byte) any {
+	iter, err := d.db.NewIter(prefixRange(prefix))
+	return &Iter{
+		iter: iter,
+		err:  err,
+	}
+}
+
+// prefixRange returns the iterator bounds of keys prefixed with prefix.
+func prefixRange(prefix []byte) *pebble.IterOptions {
+	opts := &pebble.IterOptions{}
+	if len(prefix) == 0 {
+		return opts
+	}
+	opts.LowerBound = prefix
+	for i := len(prefix) - 1; i >= 0; i-- {
+		if prefix[i] < 0xff {
+			limit := make([]byte, i+1)
+			copy(limit, prefix)
+			limit[i]++
+			opts.UpperBound = limit
+			break
+		}
+	}
+	return opts
+}
+
+// Iter is the iterator for pebble, it moves to the first item at the first Next like leveldb
+type Iter struct {
+	iter    *pebble.Iterator
+	err     error
+	started bool
+}
+
+// Next do next item of iterator
+func (i *Iter) Next() bool {
+	if i.iter == nil {
+		return false
+	}
+	if !i.started {
+		i.started = true
+		return i.iter.First()
+	}
+	return i.iter.Next()
+}
+
+// Key returns the key of current item, it returns nil after the iterator is released like leveldb
+func (i *Iter) Key() []byte {
+	if i.iter == nil {
+		return nil
+	}
+	return i.iter.Key()
+}
+
+// Value returns the value of current item, it returns nil after the iterator is released like leveldb
+func (i *Iter) Value() []byte {
+	if i.iter == nil {
+		return nil
+	}
+	return i.iter.Value()
+}
+
+// Error returns the error of iterator
+func (i *Iter) Error() error {
+	if i.err != nil || i.iter == nil {
+		return i.err
+	}
+	return i.iter.Error()
+}
+
+// Release will release the iterator
+func (i *Iter) Release() {
+	if i.iter != nil {
+		i.err = i.iter.Error()
+		i.iter.Close()
+		i.iter = nil
+	}
+}
@@ -1,18 +1,52 @@
 package kv
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/iost-official/go-iost/v3/db/kv/leveldb"
+	"github.com/iost-official/go-iost/v3/db/kv/memory"
+	"github.com/iost-official/go-iost/v3/db/kv/pebble"
 )
 
-// StorageType is the type of storage, include leveldb and rocksdb
+// StorageType is the type of storage, include leveldb, pebble and memory
 type StorageType uint8
 
 // Storage type constant
 const (
 	_ StorageType = iota
 	LevelDBStorage
+	MemoryStorage
+	PebbleStorage
 )
 
+var storageTypeNames = map[StorageType]string{
+	LevelDBStorage: "leveldb",
+	MemoryStorage:  "memory",
+	PebbleStorage:  "pebble",
+}
+
+// String returns the name of storage type
+func (t StorageType) String() string {
+	if name, ok := storageTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", uint8(t))
+}
+
+// ParseStorageType returns the storage type of the name, empty name means leveldb.
+func ParseStorageType(name string) (StorageType, error) {
+	if name == "" {
+		return LevelDBStorage, nil
+	}
+	for t, n := range storageTypeNames {
+		if n == name {
+			return t, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown storage type: %v", name)
+}
+
 // StorageBackend is the storage backend interface
 type StorageBackend interface {
 	Get(key []byte) ([]byte, error)
@@ -42,6 +76,18 @@ func NewStorage(path string, t StorageType) (*Storage, error) {
 			return nil, err
 		}
 		return &Storage{StorageBackend: sb}, nil
+	case PebbleStorage:
+		sb, err := pebble.NewDB(path)
+		if err != nil {
+			return nil, err
+		}
+		return &Storage{StorageBackend: sb}, nil
+	case MemoryStorage:
+		sb, err := memory.NewDB(path)
+		if err != nil {
+			return nil, err
+		}
+		return &Storage{StorageBackend: sb}, nil
 	default:
 		sb, err := leveldb.NewDB(path)
 		if err != nil {
@@ -51,6 +97,17 @@ func NewStorage(path string, t StorageType) (*Storage, error) {
 	}
 }
 
+// RemoveStorage removes all data of the storage, it should be closed before
+func RemoveStorage(path string, t StorageType) error {
+	switch t {
+	case MemoryStorage:
+		memory.Remove(path)
+		return nil
+	default:
+		return os.RemoveAll(path)
+	}
+}
+
 // NewIteratorByPrefix returns a new iterator by prefix
 func (s *Storage) NewIteratorByPrefix(prefix []byte) *Iterator {
 	ib := s.StorageBackend.NewIteratorByPrefix(prefix).(IteratorBackend)
@@ -204,20 +204,47 @@ func (suite *StorageTestSuite) TestRecover() {
 	suite.Equal([]byte{}, value)
 }
 
+func (suite *StorageTestSuite) TestIteratorRelease() {
+	iter := suite.storage.NewIteratorByPrefix([]byte("key"))
+	suite.True(iter.Next())
+	suite.Equal([]byte("key01"), iter.Key())
+	iter.Release()
+	suite.Nil(iter.Error())
+	suite.Nil(iter.Key())
+	suite.Nil(iter.Value())
+	suite.False(iter.Next())
+}
+
 func (suite *StorageTestSuite) TearDownTest() {
 	err := suite.storage.Close()
 	suite.Nil(err)
-	cmd := exec.Command("rm", "-r", DBPATH)
-	err = cmd.Run()
+	err = RemoveStorage(DBPATH, suite.t)
 	suite.Require().Nil(err)
 }
 
 func TestStorageTestSuite(t *testing.T) {
-	suite.Run(t, &StorageTestSuite{t: LevelDBStorage})
+	for _, st := range []StorageType{LevelDBStorage, PebbleStorage, MemoryStorage} {
+		t.Run(st.String(), func(t *testing.T) {
+			suite.Run(t, &StorageTestSuite{t: st})
+		})
+	}
+}
+
+func TestParseStorageType(t *testing.T) {
+	for _, st := range []StorageType{LevelDBStorage, PebbleStorage, MemoryStorage} {
+		parsed, err := ParseStorageType(st.String())
+		assert.Nil(t, err)
+		assert.Equal(t, st, parsed)
+	}
+	parsed, err := ParseStorageType("")
+	assert.Nil(t, err)
+	assert.Equal(t, LevelDBStorage, parsed)
+	_, err = ParseStorageType("rocksdb")
+	assert.NotNil(t, err)
 }
 
 func BenchmarkStorage(b *testing.B) {
-	for _, t := range []StorageType{LevelDBStorage} {
+	for _, t := range []StorageType{LevelDBStorage, PebbleStorage, MemoryStorage} {
 		storage, err := NewStorage(DBPATH, t)
 		if err != nil {
 			b.Fatalf("Failed to new storage: %v", err)
@@ -263,13 +290,12 @@ func BenchmarkStorage(b *testing.B) {
 		})
 
 		storage.Close()
-		cmd := exec.Command("rm", "-r", DBPATH)
-		cmd.Run()
+		RemoveStorage(DBPATH, t)
 	}
 }
 
 func BenchmarkKeys(b *testing.B) {
-	for _, t := range []StorageType{LevelDBStorage} {
+	for _, t := range []StorageType{LevelDBStorage, PebbleStorage, MemoryStorage} {
 		storage, err := NewStorage(DBPATH, t)
 		if err != nil {
 			b.Fatalf("Failed to new storage: %v", err)
@@ -311,8 +337,7 @@ func BenchmarkKeys(b *testing.B) {
 			}
 		})
 		storage.Close()
-		cmd := exec.Command("rm", "-r", DBPATH)
-		cmd.Run()
+		RemoveStorage(DBPATH, t)
 	}
 }
 
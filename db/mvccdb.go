@@ -40,7 +40,12 @@ type MVCCDB interface {
 
 // NewMVCCDB return new mvccdb
 func NewMVCCDB(path string) (MVCCDB, error) {
-	return NewCacheMVCCDB(path, mvcc.MapCache)
+	return NewMVCCDBWithStorage(path, kv.LevelDBStorage)
+}
+
+// NewMVCCDBWithStorage return new mvccdb on the specify storage type
+func NewMVCCDBWithStorage(path string, storageType kv.StorageType) (MVCCDB, error) {
+	return NewCacheMVCCDB(path, storageType, mvcc.MapCache)
 }
 
 // Item is the value of cache
@@ -146,11 +151,12 @@ type CacheMVCCDB struct {
 	storage *kv.Storage
 	cm      *CommitManager
 	rwmu    sync.RWMutex
+	archive *archive
 }
 
 // NewCacheMVCCDB returns new CacheMVCCDB
-func NewCacheMVCCDB(path string, cacheType mvcc.CacheType) (*CacheMVCCDB, error) {
-	storage, err := kv.NewStorage(path, kv.LevelDBStorage)
+func NewCacheMVCCDB(path string, storageType kv.StorageType, cacheType mvcc.CacheType) (*CacheMVCCDB, error) {
+	storage, err := kv.NewStorage(path, storageType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to new storage: %v", err)
 	}
@@ -263,52 +269,57 @@ func (m *CacheMVCCDB) Has(table string, key string) (bool, error) {
 	return true, nil
 }
 
-// Keys returns the list of key prefixed with prefix in the table
+// KeysByRange returns the keys in [from, to) of the table in order, at most limit keys if limit is positive.
+// The keys deleted in cache are filtered out before the limit is applied.
 func (m *CacheMVCCDB) KeysByRange(table string, from string, to string, limit int) ([]string, error) {
 	if !m.isValidTable(table) {
 		return nil, ErrTableNotValid
 	}
 
-	var deletedKeys []string
-	var cachedKeys []string
-	for _, v := range m.stage.All([]byte("")) {
+	// items of the later commits come after the earlier ones, so the last item of a key is the latest
+	items := make(map[string]*Item)
+	for _, v := range m.stage.All([]byte(table + string(SEPARATOR))) {
 		item, ok := v.(*Item)
-		if !ok {
+		if !ok || item.table != table {
 			continue
 		}
-		if !(from <= item.key && item.key < to) {
-			continue
+		if from <= item.key && item.key < to {
+			items[item.key] = item
 		}
+	}
+	deletedKeys := make(map[string]bool)
+	cachedKeys := make(map[string]bool)
+	for k, item := range items {
 		if item.deleted {
-			deletedKeys = append(deletedKeys, item.key)
+			deletedKeys[k] = true
 		} else {
-			cachedKeys = append(cachedKeys, item.key)
+			cachedKeys[k] = true
 		}
 	}
 
 	fromBytes := []byte(table + string(SEPARATOR) + from)
 	toBytes := []byte(table + string(SEPARATOR) + to)
-	keys, err := m.storage.KeysByRange(fromBytes, toBytes, limit)
+	// fetch more keys from storage for the deleted ones, so that there are limit keys left at least
+	storageLimit := limit
+	if limit > 0 {
+		storageLimit += len(deletedKeys)
+	}
+	keys, err := m.storage.KeysByRange(fromBytes, toBytes, storageLimit)
 	if err != nil {
 		return nil, err
 	}
 	results := make([]string, 0, len(keys)+len(cachedKeys))
 	for _, item := range keys {
 		keyWithoutPrefix := strings.TrimPrefix(string(item), table+string(SEPARATOR))
-		deleted := false
-		for _, s := range deletedKeys {
-			if s == keyWithoutPrefix {
-				deleted = true
-				break
-			}
-		}
-		if !deleted {
+		if !deletedKeys[keyWithoutPrefix] && !cachedKeys[keyWithoutPrefix] {
 			results = append(results, keyWithoutPrefix)
 		}
 	}
-	results = append(results, cachedKeys...)
+	for k := range cachedKeys {
+		results = append(results, k)
+	}
 	sort.Strings(results)
-	if len(results) > limit {
+	if limit > 0 && len(results) > limit {
 		results = results[:limit]
 	}
 	return results, nil
@@ -357,6 +368,7 @@ func (m *CacheMVCCDB) Fork() MVCCDB {
 		stage:   m.head.ForkCache(),
 		storage: m.storage,
 		cm:      m.cm,
+		archive: m.archive,
 	}
 	return mvccdb
 }
@@ -367,6 +379,12 @@ func (m *CacheMVCCDB) Flush(t string) error {
 	if commit == nil {
 		return fmt.Errorf("not found tag: %v", t)
 	}
+	var version int64
+	if m.archive != nil {
+		m.archive.rwmu.Lock()
+		defer m.archive.rwmu.Unlock()
+		version = m.archive.version + 1
+	}
 	if err := m.storage.BeginBatch(); err != nil {
 		return err
 	}
@@ -379,6 +397,11 @@ func (m *CacheMVCCDB) Flush(t string) error {
 		if !ok {
 			return fmt.Errorf("can't assert Item type")
 		}
+		if m.archive != nil {
+			if err := m.archive.record(m.storage, version, []byte(item.table+string(SEPARATOR)+item.key), item); err != nil {
+				return err
+			}
+		}
 		if item.deleted {
 			err := m.storage.Delete([]byte(item.table + string(SEPARATOR) + item.key))
 			if err != nil {
@@ -391,9 +414,21 @@ func (m *CacheMVCCDB) Flush(t string) error {
 			}
 		}
 	}
+	if m.archive != nil {
+		if err := m.archive.finish(m.storage, version, t); err != nil {
+			return err
+		}
+	}
 	if err := m.storage.CommitBatch(); err != nil {
 		return err
 	}
+	if m.archive != nil {
+		minVersion, err := m.archive.pruneTo(m.storage, version)
+		if err != nil {
+			return err
+		}
+		m.archive.version, m.archive.minVersion = version, minVersion
+	}
 	m.cm.FreeBefore(commit)
 	return nil
 }
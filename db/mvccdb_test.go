@@ -1,12 +1,12 @@
 package db
 
 import (
-	"os/exec"
 	"testing"
 
 	"os"
 	"time"
 
+	"github.com/iost-official/go-iost/v3/db/kv"
 	"github.com/iost-official/go-iost/v3/ilog"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -19,10 +19,11 @@ const (
 type MVCCDBTestSuite struct {
 	suite.Suite
 	mvccdb MVCCDB
+	t      kv.StorageType
 }
 
 func (suite *MVCCDBTestSuite) SetupTest() {
-	mvccdb, err := NewMVCCDB(DBPATH)
+	mvccdb, err := NewMVCCDBWithStorage(DBPATH, suite.t)
 	require.Nil(suite.T(), err, "Create MVCCDB should not fail")
 	suite.mvccdb = mvccdb
 	suite.mvccdb.Put("table01", "key01", "value01")
@@ -210,7 +211,7 @@ func (suite *MVCCDBTestSuite) TestRecovery() {
 	err = suite.mvccdb.Close()
 	suite.Nil(err, "Close MVCCDB should not fail")
 
-	mvccdb, err := NewMVCCDB(DBPATH)
+	mvccdb, err := NewMVCCDBWithStorage(DBPATH, suite.t)
 	require.Nil(suite.T(), err, "Create MVCCDB should not fail")
 	suite.mvccdb = mvccdb
 
@@ -255,17 +256,38 @@ func (suite *MVCCDBTestSuite) TestFlushAndCheckout() {
 	suite.Equal("", value)
 }
 
+func (suite *MVCCDBTestSuite) TestKeysByRange() {
+	err := suite.mvccdb.Flush("tag0")
+	suite.Nil(err)
+
+	suite.mvccdb.Del("table01", "key01")
+	suite.mvccdb.Del("table01", "key02")
+	suite.mvccdb.Put("table01", "key025", "value11")
+	suite.mvccdb.Put("table01", "key03", "value12")
+	suite.mvccdb.Put("table02", "key00", "value13")
+
+	keys, err := suite.mvccdb.KeysByRange("table01", "key", "kez", 2)
+	suite.Nil(err)
+	suite.Equal([]string{"key025", "key03"}, keys)
+	keys, err = suite.mvccdb.KeysByRange("table01", "key", "kez", 0)
+	suite.Nil(err)
+	suite.Equal([]string{"key025", "key03", "key04", "key05"}, keys)
+}
+
 func (suite *MVCCDBTestSuite) TearDownTest() {
 	err := suite.mvccdb.Close()
 	suite.Nil(err, "Close MVCCDB should not fail")
 
-	cmd := exec.Command("rm", "-r", DBPATH)
-	err = cmd.Run()
+	err = kv.RemoveStorage(DBPATH, suite.t)
 	require.Nil(suite.T(), err, "Remove database should not fail")
 }
 
 func TestMVCCDBTestSuite(t *testing.T) {
-	suite.Run(t, new(MVCCDBTestSuite))
+	for _, st := range []kv.StorageType{kv.LevelDBStorage, kv.PebbleStorage, kv.MemoryStorage} {
+		t.Run(st.String(), func(t *testing.T) {
+			suite.Run(t, &MVCCDBTestSuite{t: st})
+		})
+	}
 }
 
 func TestPutTimeout(t *testing.T) {
@@ -4,6 +4,7 @@ import (
 	"github.com/iost-official/go-iost/v3/chainbase"
 	"github.com/iost-official/go-iost/v3/common"
 	"github.com/iost-official/go-iost/v3/consensus"
+	"github.com/iost-official/go-iost/v3/consensus/snapshot"
 	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/ilog"
 	"github.com/iost-official/go-iost/v3/metrics/exporter"
@@ -26,12 +27,17 @@ type IServer struct {
 	consensus consensus.Consensus
 	debug     *DebugServer
 	exporter  *exporter.Exporter
+	snapshot  *snapshot.Server
 }
 
 // New returns a iserver application
 func New(conf *common.Config) *IServer {
 	tx.ChainID = conf.P2P.ChainID
 
+	if err := snapshot.FastSync(conf); err != nil {
+		ilog.Fatalf("Sync snapshot failed: %v.", err)
+	}
+
 	cBase, err := chainbase.New(conf)
 	if err != nil {
 		ilog.Fatalf("New chainbase failed: %v.", err)
@@ -51,6 +57,11 @@ func New(conf *common.Config) *IServer {
 
 	debug := NewDebugServer(conf.Debug, p2pService, cBase.BlockCache(), cBase.BlockChain())
 
+	var snapshotServer *snapshot.Server
+	if conf.Snapshot != nil && conf.Snapshot.Serve {
+		snapshotServer = snapshot.NewServer(conf.Snapshot, p2pService, cBase.BlockChain())
+	}
+
 	return &IServer{
 		config:    conf,
 		cBase:     cBase,
@@ -59,6 +70,7 @@ func New(conf *common.Config) *IServer {
 		consensus: consensus,
 		debug:     debug,
 		exporter:  exporter,
+		snapshot:  snapshotServer,
 	}
 }
 
@@ -80,6 +92,11 @@ func (s *IServer) Start() error {
 			return err
 		}
 	}
+	if s.snapshot != nil {
+		if err := s.snapshot.Start(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -89,6 +106,9 @@ func (s *IServer) Stop() {
 	if conf.Debug != nil {
 		s.debug.Stop()
 	}
+	if s.snapshot != nil {
+		s.snapshot.Stop()
+	}
 	Services := []Service{
 		s.rpcServer,
 		s.consensus,
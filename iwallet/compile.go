@@ -2,11 +2,15 @@ package iwallet
 
 import (
 	_ "embed" //nolint, need go1.16 or later
+	"encoding/json"
 	"fmt"
+	"os"
 	"os/exec"
 	"strings"
 
 	"github.com/spf13/cobra"
+
+	"github.com/iost-official/go-iost/v3/vm/wasm"
 )
 
 // CompiledContract is the compiled js code. Need go 1.16 or later
@@ -35,12 +39,34 @@ func generateABI(codePath string) (string, error) {
 	return codePath + ".abi", nil
 }
 
+// Generate ABI file of wasm code, the args of abi should be filled after it is generated.
+func generateWasmABI(codePath string) (string, error) {
+	code, err := os.ReadFile(codePath)
+	if err != nil {
+		return "", err
+	}
+	info, err := wasm.GenerateABI(code)
+	if err != nil {
+		return "", err
+	}
+	abi, err := json.MarshalIndent(info, "", "    ")
+	if err != nil {
+		return "", err
+	}
+	abiPath := codePath + ".abi"
+	if err := os.WriteFile(abiPath, abi, 0644); err != nil {
+		return "", err
+	}
+	return abiPath, nil
+}
+
 // compileCmd represents the compile command.
 var compileCmd = &cobra.Command{
-	Use:     "compile codePath",
-	Short:   "Generate contract abi",
-	Long:    `Generate abi from contract javascript code`,
-	Example: `  iwallet compile ./example.js`,
+	Use:   "compile codePath",
+	Short: "Generate contract abi",
+	Long:  `Generate abi from contract javascript or wasm code, the args of wasm abi should be filled after it is generated`,
+	Example: `  iwallet compile ./example.js
+  iwallet compile ./example.wasm`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		if err := checkArgsNumber(cmd, args, "codePath"); err != nil {
 			return err
@@ -49,7 +75,11 @@ var compileCmd = &cobra.Command{
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		codePath := args[0]
-		abiPath, err := generateABI(codePath)
+		generate := generateABI
+		if strings.HasSuffix(codePath, ".wasm") {
+			generate = generateWasmABI
+		}
+		abiPath, err := generate(codePath)
 		if err != nil {
 			return fmt.Errorf("failed to generate abi: %v", err)
 		}
@@ -0,0 +1,226 @@
+package iwallet
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/iost-official/go-iost/v3/account"
+	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+	"github.com/iost-official/go-iost/v3/sdk"
+)
+
+// multisigCmd represents the command group of multi-signature transaction.
+var multisigCmd = &cobra.Command{
+	Use:   "multisig",
+	Short: "Multi-signature transaction workflow",
+	Long: `Multi-signature transaction workflow
+	A multi-signature transaction file is the transaction request json without publisher signature, which collects the signatures of its signers.
+	It is created once, signed by each signer (or signed separately and combined), and sent by the publisher after the thresholds of signers are satisfied.`,
+	Example: `  iwallet multisig create "token.iost" "transfer" '["iost","user0001","user0002","10",""]' --signers user0001@active --amount_limit iost:10 --output tx.json
+  iwallet multisig sign tx.json --account user0001
+  iwallet multisig status tx.json
+  iwallet multisig send tx.json --account user0002`,
+}
+
+var multisigCreateCmd = &cobra.Command{
+	Use:   "create [ACTION]...",
+	Short: "Create a multi-signature transaction file",
+	Long: `Create a multi-signature transaction file by given actions and signers, saved as the file by flag --output
+	The amount limit should be set by --amount_limit, since it can't be inferred before the signers sign.`,
+	Example: `  iwallet multisig create "token.iost" "transfer" '["iost","user0001","user0002","10",""]' --signers user0001@active --amount_limit iost:10 --output tx.json`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 || len(args)%3 != 0 {
+			return errorWithHelp(cmd, "number of args should be a positive multiplier of 3")
+		}
+		if outputTxFile == "" {
+			return errorWithHelp(cmd, "please provide the transaction file with flag --output/-o")
+		}
+		if len(signers) == 0 {
+			return errorWithHelp(cmd, "please provide the signers with flag --signers")
+		}
+		if amountLimit == autoAmountLimit {
+			return errorWithHelp(cmd, "amount limit can't be inferred for multiple signature transaction, please provide it with flag --amount_limit")
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var actions = make([]*rpcpb.Action, 0)
+		for i := 0; i < len(args); i += 3 {
+			v, err := formatContractArgs(args[i+2])
+			if err != nil {
+				return err
+			}
+			actions = append(actions, sdk.NewAction(args[i], args[i+1], v))
+		}
+		tx, err := createTxFromActions(actions)
+		if err != nil {
+			return err
+		}
+		return saveTx(tx)
+	},
+}
+
+var multisigSignCmd = &cobra.Command{
+	Use:   "sign txFile",
+	Short: "Sign a multi-signature transaction file as a signer",
+	Long: `Sign a multi-signature transaction file with the key of permission by flag --sign_permission, and add the signature into the file
+	The signed transaction is saved as the file by flag --output if it is given.`,
+	Example: `  iwallet multisig sign tx.json --account user0001
+  iwallet multisig sign tx.json --key_file user0001.json --sign_permission owner --output tx_user0001.json`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := checkArgsNumber(cmd, args, "txFile"); err != nil {
+			return err
+		}
+		return checkAccount(cmd)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		tx, err := loadMultisigTx(args[0])
+		if err != nil {
+			return err
+		}
+		acc, err := loadAccount(true)
+		if err != nil {
+			return err
+		}
+		kp, err := acc.GetKeyPair(signPerm)
+		if err != nil {
+			return fmt.Errorf("failed to get key pair of permission %v: %v", signPerm, err)
+		}
+		sdk.AddSignerSignature(tx, kp)
+		output := outputTxFile
+		if output == "" {
+			output = args[0]
+		}
+		if err := sdk.SaveProtoStructToJSONFile(tx, output); err != nil {
+			return fmt.Errorf("failed to save transaction file %v: %v", output, err)
+		}
+		fmt.Printf("Successfully signed as %v@%v and saved transaction file: %v\n", acc.Name, signPerm, output)
+		return nil
+	},
+}
+
+var multisigStatusCmd = &cobra.Command{
+	Use:     "status txFile",
+	Short:   "Show which signer thresholds are satisfied",
+	Long:    `Show the weight and threshold of each signer permission, by the collected signatures and the permissions of accounts on chain`,
+	Example: `  iwallet multisig status tx.json`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		return checkArgsNumber(cmd, args, "txFile")
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		tx, err := loadMultisigTx(args[0])
+		if err != nil {
+			return err
+		}
+		_, err = printSignerStatus(tx)
+		return err
+	},
+}
+
+var multisigCombineCmd = &cobra.Command{
+	Use:     "combine outputFile txFile...",
+	Short:   "Combine the signatures of multi-signature transaction files",
+	Long:    `Combine the signatures of the same transaction signed separately, and save it as outputFile`,
+	Example: `  iwallet multisig combine tx.json tx_user0001.json tx_user0002.json`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		return checkArgsNumber(cmd, args, "outputFile", "txFile")
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		txs := make([]*rpcpb.TransactionRequest, 0, len(args)-1)
+		for _, f := range args[1:] {
+			tx, err := loadMultisigTx(f)
+			if err != nil {
+				return err
+			}
+			txs = append(txs, tx)
+		}
+		tx, err := sdk.CombineSignedTxs(txs...)
+		if err != nil {
+			return err
+		}
+		if err := sdk.SaveProtoStructToJSONFile(tx, args[0]); err != nil {
+			return fmt.Errorf("failed to save transaction file %v: %v", args[0], err)
+		}
+		fmt.Printf("Successfully combined %v signatures and saved transaction file: %v\n", len(tx.Signatures), args[0])
+		return nil
+	},
+}
+
+var multisigSendCmd = &cobra.Command{
+	Use:     "send txFile",
+	Short:   "Send a multi-signature transaction as the publisher",
+	Long:    `Send a multi-signature transaction after all thresholds of its signers are satisfied`,
+	Example: `  iwallet multisig send tx.json --account user0002`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := checkArgsNumber(cmd, args, "txFile"); err != nil {
+			return err
+		}
+		return checkAccount(cmd)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		tx, err := loadMultisigTx(args[0])
+		if err != nil {
+			return err
+		}
+		if err := prepareTx(tx); err != nil {
+			return err
+		}
+		// the publisher signature counts toward the signer permissions on chain too
+		if _, err := iwalletSDK.SignTx(tx); err != nil {
+			return err
+		}
+		ok, err := printSignerStatus(tx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("the thresholds of signers are not satisfied yet")
+		}
+		_, err = iwalletSDK.SendTx(tx)
+		return err
+	},
+}
+
+func loadMultisigTx(f string) (*rpcpb.TransactionRequest, error) {
+	tx := &rpcpb.TransactionRequest{}
+	if err := sdk.LoadProtoStructFromJSONFile(f, tx); err != nil {
+		return nil, fmt.Errorf("failed to load transaction file %v: %v", f, err)
+	}
+	if len(tx.PublisherSigs) != 0 {
+		return nil, fmt.Errorf("transaction file %v is signed by publisher already", f)
+	}
+	return tx, nil
+}
+
+// printSignerStatus prints the status of signers, and returns whether all of them are satisfied.
+func printSignerStatus(tx *rpcpb.TransactionRequest) (bool, error) {
+	for _, sig := range tx.Signatures {
+		if !sdk.VerifySignerSignature(tx, sig) {
+			fmt.Println("Invalid signature of public key:", account.EncodePubkey(sig.PublicKey))
+		}
+	}
+	status, err := iwalletSDK.SignerStatus(tx)
+	if err != nil {
+		return false, err
+	}
+	allSatisfied := true
+	for _, s := range status {
+		mark := "satisfied"
+		if !s.Satisfied {
+			mark = "not satisfied"
+			allSatisfied = false
+		}
+		fmt.Printf("%v: weight %v/%v, %v\n", s.Signer, s.Weight, s.Threshold, mark)
+	}
+	return allSatisfied, nil
+}
+
+func init() {
+	rootCmd.AddCommand(multisigCmd)
+	multisigCmd.AddCommand(multisigCreateCmd)
+	multisigCmd.AddCommand(multisigSignCmd)
+	multisigCmd.AddCommand(multisigStatusCmd)
+	multisigCmd.AddCommand(multisigCombineCmd)
+	multisigCmd.AddCommand(multisigSendCmd)
+}
@@ -19,6 +19,7 @@ var publishCmd = &cobra.Command{
 	Short:   "Publish a contract",
 	Long:    `Publish a contract by a contract and an abi file`,
 	Example: `  iwallet publish ./example.js ./example.js.abi --account test0
+  iwallet publish ./example.wasm ./example.wasm.abi --account test0
   iwallet publish -u ./example.js ./example.js.abi ContractXXX --account test0`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		var err error
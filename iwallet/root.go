@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
 	"github.com/iost-official/go-iost/v3/sdk"
 )
 
@@ -34,9 +35,14 @@ var rootCmd = &cobra.Command{
 		iwalletSDK.SetServer(server)
 		iwalletSDK.SetVerbose(verbose)
 		iwalletSDK.SetCheckResult(checkResult, checkResultDelay, checkResultMaxRetry)
-		limit, err := ParseAmountLimit(amountLimit)
-		if err != nil {
-			return fmt.Errorf("invalid amount limit %v: %v", amountLimit, err)
+		// the auto amount limit is inferred by iwallet after the signers are set, see inferAmountLimit
+		limit := []*rpcpb.AmountLimit{}
+		if amountLimit != autoAmountLimit {
+			var err error
+			limit, err = ParseAmountLimit(amountLimit)
+			if err != nil {
+				return fmt.Errorf("invalid amount limit %v: %v", amountLimit, err)
+			}
 		}
 		if !(0 < expiration && expiration <= 90) {
 			return fmt.Errorf("expiration should be in (0, 90]")
@@ -84,9 +90,10 @@ func init() {
 	rootCmd.PersistentFlags().Int32VarP(&checkResultMaxRetry, "check_result_max_retry", "", 30, "max times to call grpc to check tx status")
 	rootCmd.PersistentFlags().StringVarP(&signAlgo, "sign_algo", "", "ed25519", "sign algorithm")
 	rootCmd.PersistentFlags().StringSliceVarP(&signers, "signers", "", []string{}, "additional signers")
-	rootCmd.PersistentFlags().Float64VarP(&gasLimit, "gas_limit", "l", 1000000, "gas limit for a transaction")
+	rootCmd.PersistentFlags().Float64VarP(&gasLimit, "gas_limit", "l", 0, "gas limit for a transaction, estimated by the node if it isn't given")
 	rootCmd.PersistentFlags().Float64VarP(&gasRatio, "gas_ratio", "p", 1.0, "gas ratio for a transaction")
-	rootCmd.PersistentFlags().StringVarP(&amountLimit, "amount_limit", "", "*:unlimited", "amount limit for one transaction, eg iost:300.00|ram:2000")
+	rootCmd.PersistentFlags().StringVarP(&amountLimit, "amount_limit", "", "*:unlimited", "amount limit for one transaction, eg iost:300.00|ram:2000, or auto to infer it by a dry run on the node (rpc.exectx should be enabled)")
+	rootCmd.PersistentFlags().BoolVarP(&assumeYes, "yes", "y", false, "send the transaction with the inferred amount limit without asking")
 	rootCmd.PersistentFlags().Int64VarP(&expiration, "expiration", "e", 90, "expiration time for a transaction in seconds")
 	rootCmd.PersistentFlags().Uint32VarP(&chainID, "chain_id", "", uint32(1024), "chain id which distinguishes different network")
 	rootCmd.PersistentFlags().StringVarP(&txTime, "tx_time", "", "", fmt.Sprintf("use the special tx time instead of now, format: %v", time.Now().Format(time.RFC3339)))
@@ -145,6 +152,7 @@ var (
 	gasRatio     float64
 	expiration   int64
 	amountLimit  string
+	assumeYes    bool
 	delaySecond  int64
 	txTime       string
 	txTimeDelay  uint32
@@ -1,9 +1,12 @@
 package iwallet
 
 import (
+	"bufio"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math"
+	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -56,6 +59,9 @@ func checkSigners(signers []string) error {
 
 ///////////////////////// construct tx /////////////////////////
 
+// autoAmountLimit means the amount limit is inferred from the tokens charged in a dry run
+const autoAmountLimit = "auto"
+
 // ParseAmountLimit ...
 func ParseAmountLimit(limitStr string) ([]*rpcpb.AmountLimit, error) {
 	result := make([]*rpcpb.AmountLimit, 0)
@@ -233,6 +239,10 @@ func sendTx(tx *rpcpb.TransactionRequest) (string, error) {
 }
 
 func saveTx(tx *rpcpb.TransactionRequest) error {
+	if tx.GasLimit == 0 {
+		// the saved tx may be signed offline, so the gas limit can't be estimated when it is sent
+		tx.GasLimit = sdk.DefaultGasLimit
+	}
 	err := sdk.SaveProtoStructToJSONFile(tx, outputTxFile)
 	if err != nil {
 		return err
@@ -245,7 +255,55 @@ func saveTx(tx *rpcpb.TransactionRequest) error {
 	return nil
 }
 
+// inferAmountLimit sets the amount limit to the tokens charged in a dry run if the amount limit is auto.
+func inferAmountLimit(tx *rpcpb.TransactionRequest) error {
+	if amountLimit != autoAmountLimit {
+		return nil
+	}
+	if iwalletSDK.CurrentAccount() == "" {
+		if accountName == "" && keyFile == "" {
+			return fmt.Errorf("amount limit can't be inferred without account, please set it by --amount_limit")
+		}
+		if err := initAccountForSDK(iwalletSDK); err != nil {
+			return err
+		}
+	}
+	limit, err := iwalletSDK.EstimateAmountLimit(tx)
+	if err != nil {
+		return fmt.Errorf("failed to infer amount limit, please set it by --amount_limit: %v", err)
+	}
+	tx.AmountLimit = limit
+	if len(limit) == 0 {
+		fmt.Println("No token will be spent by the transaction")
+		return nil
+	}
+	fmt.Println("Inferred amount limit:")
+	for _, l := range limit {
+		fmt.Printf("  %v: %v\n", l.Token, l.Value)
+	}
+	// the saved tx isn't sent, it can be checked before signing
+	if outputTxFile == "" && !assumeYes && !confirm(os.Stdin, "Continue with the inferred amount limit?") {
+		return fmt.Errorf("canceled, the inferred amount limit is rejected")
+	}
+	return nil
+}
+
+// confirm asks the question and returns true only if the answer read from r is yes.
+func confirm(r io.Reader, question string) bool {
+	fmt.Printf("%v [y/N]: ", question)
+	answer, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && answer == "" {
+		fmt.Println()
+		return false
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
 func processTx(tx *rpcpb.TransactionRequest) (string, error) {
+	if err := inferAmountLimit(tx); err != nil {
+		return "", err
+	}
 	if outputTxFile != "" {
 		return "", saveTx(tx)
 	}
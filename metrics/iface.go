@@ -8,6 +8,7 @@ type Counter interface {
 // Gauge defines the API of gauge-type metrics.
 type Gauge interface {
 	Set(float64, map[string]string) error
+	Delete(map[string]string) bool
 }
 
 // Summary defines the API of summary-type metrics.
@@ -46,6 +46,11 @@ func (p *PromGauge) Set(value float64, tagkv map[string]string) error {
 	return nil
 }
 
+// Delete removes the prometheus Gauge with the given labels, and returns whether it exists.
+func (p *PromGauge) Delete(tagkv map[string]string) bool {
+	return p.gaugeVec.Delete(prometheus.Labels(tagkv))
+}
+
 // PromSummary is the implementation of Summary with prometheus's SummaryVec.
 type PromSummary struct {
 	summaryVec *prometheus.SummaryVec
@@ -52,6 +52,16 @@ const (
 	SyncBlockResponse
 	SyncHeight
 	PublishTx
+	NewTxHash
+	TxRequest
+	NewCompactBlock
+	BlockTxRequest
+	BlockTxResponse
+	SnapshotQuery
+	SnapshotOffer
+	SnapshotChunkRequest
+	SnapshotChunkResponse
+	TxResponse
 
 	UrgentMessage = 1
 	NormalMessage = 2
@@ -81,6 +91,26 @@ func (m MessageType) String() string {
 		return "PublishTx"
 	case NewBlockHash:
 		return "NewBlockHash"
+	case NewTxHash:
+		return "NewTxHash"
+	case TxRequest:
+		return "TxRequest"
+	case NewCompactBlock:
+		return "NewCompactBlock"
+	case BlockTxRequest:
+		return "BlockTxRequest"
+	case BlockTxResponse:
+		return "BlockTxResponse"
+	case SnapshotQuery:
+		return "SnapshotQuery"
+	case SnapshotOffer:
+		return "SnapshotOffer"
+	case SnapshotChunkRequest:
+		return "SnapshotChunkRequest"
+	case SnapshotChunkResponse:
+		return "SnapshotChunkResponse"
+	case TxResponse:
+		return "TxResponse"
 	default:
 		return "unknown_type:" + strconv.Itoa(int(m))
 	}
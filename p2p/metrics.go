@@ -9,4 +9,9 @@ var (
 	packetOutCounter   = metrics.NewCounter("iost_p2p_packet_out", []string{"mtype"})
 	byteInCounter      = metrics.NewCounter("iost_p2p_bytes_in", []string{"mtype"})
 	packetInCounter    = metrics.NewCounter("iost_p2p_packet_in", []string{"mtype"})
+
+	peerScoreGauge       = metrics.NewGauge("iost_p2p_peer_score", []string{"pid"})
+	bannedPeerCountGauge = metrics.NewGauge("iost_p2p_banned_peer_count", nil)
+	peerBehaviorCounter  = metrics.NewCounter("iost_p2p_peer_behavior", []string{"behavior"})
+	rateLimitCounter     = metrics.NewCounter("iost_p2p_rate_limited", []string{"mtype", "action"})
 )
@@ -135,6 +135,18 @@ func (mr *MockServiceMockRecorder) Register(arg0 interface{}, arg1 ...interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockService)(nil).Register), varargs...)
 }
 
+// ReportPeer mocks base method.
+func (m *MockService) ReportPeer(arg0 p2p.PeerID, arg1 p2p.PeerBehavior) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReportPeer", arg0, arg1)
+}
+
+// ReportPeer indicates an expected call of ReportPeer.
+func (mr *MockServiceMockRecorder) ReportPeer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportPeer", reflect.TypeOf((*MockService)(nil).ReportPeer), arg0, arg1)
+}
+
 // SendToPeer mocks base method.
 func (m *MockService) SendToPeer(arg0 peer.ID, arg1 []byte, arg2 p2p.MessageType, arg3 p2p.MessagePriority) {
 	m.ctrl.T.Helper()
@@ -45,6 +45,7 @@ type Service interface {
 	ID() string
 	ConnectBPs([]string)
 	PutPeerToBlack(string)
+	ReportPeer(PeerID, PeerBehavior)
 
 	Broadcast([]byte, MessageType, MessagePriority)
 	SendToPeer(PeerID, []byte, MessageType, MessagePriority)
@@ -89,7 +90,12 @@ func NewNetService(config *common.P2PConfig) (*NetService, error) {
 	}
 	ns.host = host
 
-	ns.PeerManager = NewPeerManager(host, config)
+	ns.PeerManager, err = NewPeerManager(host, config)
+	if err != nil {
+		ilog.Errorf("failed to create peer manager. err=%v", err)
+		host.Close()
+		return nil, err
+	}
 
 	ns.adminServer = newAdminServer(config.AdminPort, ns.PeerManager)
 
@@ -8,9 +8,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/iost-official/go-iost/v3/common"
 	"github.com/iost-official/go-iost/v3/ilog"
 
 	bloom "github.com/bits-and-blooms/bloom/v3"
+	lru "github.com/hashicorp/golang-lru/v2"
 	libnet "github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	multiaddr "github.com/multiformats/go-multiaddr"
@@ -27,6 +29,8 @@ const (
 	bloomMaxItemCount = 100000
 	bloomErrRate      = 0.001
 
+	receivedMsgCacheSize = 10000
+
 	msgChanSize          = 1024
 	maxDataLength        = 10000000 // 10MB
 	routingQueryTimeout  = 10
@@ -48,10 +52,16 @@ type Peer struct {
 	stream            libnet.Stream
 	continuousTimeout int
 
+	rateLimiter *rateLimiter
+
 	recentMsg      *bloom.BloomFilter
 	bloomMutex     sync.Mutex
 	bloomItemCount int
 
+	// receivedMsg keeps the hashes of the messages sent by the neighbor, which are exact so that a duplicate
+	// is never a false positive or a message we have sent to it.
+	receivedMsg *lru.Cache[string, struct{}]
+
 	urgentMsgCh chan *p2pMessage
 	normalMsgCh chan *p2pMessage
 
@@ -65,13 +75,16 @@ type Peer struct {
 
 // NewPeer returns a new instance of Peer struct.
 func NewPeer(stream libnet.Stream, pm *PeerManager, direction connDirection) *Peer {
+	receivedMsg, _ := lru.New[string, struct{}](receivedMsgCacheSize)
 	peer := &Peer{
 		id:          stream.Conn().RemotePeer(),
 		addr:        stream.Conn().RemoteMultiaddr(),
 		conn:        stream.Conn(),
 		stream:      stream,
 		peerManager: pm,
+		rateLimiter: newRateLimiter(pm.rateLimits),
 		recentMsg:   bloom.NewWithEstimates(bloomMaxItemCount, bloomErrRate),
+		receivedMsg: receivedMsg,
 		urgentMsgCh: make(chan *p2pMessage, msgChanSize),
 		normalMsgCh: make(chan *p2pMessage, msgChanSize),
 		quitWriteCh: make(chan struct{}),
@@ -197,11 +210,26 @@ func (p *Peer) readLoop() {
 		msg, err := parseP2PMessage(data)
 		if err != nil {
 			ilog.Errorf("parse p2pmessage failed. err=%v", err)
+			p.peerManager.ReportPeer(p.id, MalformedMessage)
 			break
 		}
 		tagkv := map[string]string{"mtype": msg.messageType().String()}
 		byteInCounter.Add(float64(len(msg.content())), tagkv)
 		packetInCounter.Add(1, tagkv)
+		action := p.rateLimiter.limit(msg.messageType(), p.quitWriteCh)
+		if action != rateLimitPass {
+			rateLimitCounter.Add(1, map[string]string{"mtype": msg.messageType().String(), "action": action})
+		}
+		if action == rateLimitDisconnect || (action == rateLimitDrop && !solicitedMessages[msg.messageType()]) {
+			p.peerManager.ReportPeer(p.id, RateLimited)
+		}
+		if action == rateLimitDisconnect {
+			ilog.Warnf("message rate exceeds the limit, disconnect the peer. pid=%v, mtype=%v", p.ID(), msg.messageType())
+			break
+		}
+		if action == rateLimitDrop {
+			continue
+		}
 		p.handleMessage(msg)
 	}
 
@@ -238,6 +266,9 @@ func (p *Peer) SendMessage(msg *p2pMessage, mp MessagePriority, deduplicate bool
 
 func (p *Peer) handleMessage(msg *p2pMessage) error {
 	if msg.needDedup() {
+		if p.isResent(msg) {
+			p.peerManager.ReportPeer(p.id, DuplicateMessage)
+		}
 		p.recordMessage(msg)
 	}
 	if msg.messageType() == RoutingTableResponse {
@@ -271,6 +302,12 @@ func (p *Peer) hasMessage(msg *p2pMessage) bool {
 	return p.recentMsg.Test(msg.content())
 }
 
+// isResent returns whether the neighbor has sent the message before, and records it.
+func (p *Peer) isResent(msg *p2pMessage) bool {
+	found, _ := p.receivedMsg.ContainsOrAdd(string(common.Sha3(msg.content())), struct{}{})
+	return found
+}
+
 // resetRoutingQueryTime resets last routing query time.
 func (p *Peer) resetRoutingQueryTime() {
 	p.lastRoutingQueryTime.Store(-1)
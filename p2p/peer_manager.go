@@ -91,10 +91,19 @@ type PeerManager struct {
 
 	retryTimes map[string]int
 	rtMutex    sync.RWMutex
+
+	scores     map[string]*peerScore
+	scoreMutex sync.Mutex
+
+	rateLimits map[MessageType]common.RateLimitConfig
 }
 
 // NewPeerManager returns a new instance of PeerManager struct.
-func NewPeerManager(host host.Host, config *common.P2PConfig) *PeerManager {
+func NewPeerManager(host host.Host, config *common.P2PConfig) (*PeerManager, error) {
+	rateLimits, err := parseRateLimits(config.RateLimit)
+	if err != nil {
+		return nil, err
+	}
 	routingTable, _ := kbucket.NewRoutingTable(bucketSize, kbucket.ConvertPeerID(host.ID()), time.Minute, host.Peerstore(), time.Second, nil)
 	pm := &PeerManager{
 		neighbors:     make(map[peer.ID]*Peer),
@@ -110,6 +119,8 @@ func NewPeerManager(host host.Host, config *common.P2PConfig) *PeerManager {
 		blackPIDs:     make(map[string]bool),
 		blackIPs:      make(map[string]bool),
 		retryTimes:    make(map[string]int),
+		scores:        make(map[string]*peerScore),
+		rateLimits:    rateLimits,
 	}
 	if config.InboundConn <= 0 {
 		pm.neighborCap[inbound] = defaultOutboundConn
@@ -129,7 +140,7 @@ func NewPeerManager(host host.Host, config *common.P2PConfig) *PeerManager {
 	for _, blackPID := range config.BlackPID {
 		pm.blackPIDs[blackPID] = true
 	}
-	return pm
+	return pm, nil
 }
 
 // Start starts peer manager's job.
@@ -260,6 +271,11 @@ func (pm *PeerManager) HandleStream(s libnet.Stream, direction connDirection) {
 		s.Conn().Close()
 		return
 	}
+	if pm.isBanned(remotePID) {
+		ilog.Infof("Remote peer is banned for low score, close connection. pid=%v, addr=%v", remotePID.String(), s.Conn().RemoteMultiaddr())
+		s.Conn().Close()
+		return
+	}
 	ilog.Debugf("handle new stream. pid=%s, addr=%v, direction=%v", remotePID.String(), s.Conn().RemoteMultiaddr(), direction)
 
 	peer := pm.GetNeighbor(remotePID)
@@ -336,6 +352,11 @@ func (pm *PeerManager) metricsStatLoop() {
 		case <-time.After(metricsStatInterval):
 			neighborCountGauge.Set(float64(pm.AllNeighborCount()), nil)
 			routingCountGauge.Set(float64(pm.routingTable.Size()), nil)
+			scores := pm.peerScores()
+			for _, p := range pm.GetAllNeighbors() {
+				peerScoreGauge.Set(scores[p.ID()], map[string]string{"pid": p.ID()})
+			}
+			bannedPeerCountGauge.Set(float64(len(pm.bannedPeers())), nil)
 		}
 	}
 }
@@ -383,6 +404,7 @@ func (pm *PeerManager) RemoveNeighbor(peerID peer.ID) {
 		p.Stop()
 		delete(pm.neighbors, peerID)
 		pm.neighborCount[p.direction]--
+		peerScoreGauge.Delete(map[string]string{"pid": p.ID()})
 	}
 }
 
@@ -547,7 +569,7 @@ func (pm *PeerManager) routingQuery(ids []string) {
 			if peerID == pm.host.ID() {
 				continue
 			}
-			if pm.GetNeighbor(peerID) != nil {
+			if pm.GetNeighbor(peerID) != nil || pm.isBanned(peerID) {
 				continue
 			}
 			queryingPeerIDs = append(queryingPeerIDs, peerID)
@@ -910,6 +932,13 @@ func (pm *PeerManager) NeighborStat() map[string]any {
 	ret["black_ips"] = blackIPs
 	ret["black_pids"] = blackPIDs
 
+	banned := make(map[string]string)
+	for pid, t := range pm.bannedPeers() {
+		banned[pid] = t.Format(time.RFC3339)
+	}
+	ret["banned_pids"] = banned
+	ret["scores"] = pm.peerScores()
+
 	in := make([]string, 0)
 	out := make([]string, 0)
 	for _, p := range pm.GetAllNeighbors() {
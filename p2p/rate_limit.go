@@ -0,0 +1,156 @@
+package p2p
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"golang.org/x/time/rate"
+)
+
+// actions to the message exceeding the rate limit
+const (
+	rateLimitPass       = "pass"
+	rateLimitDrop       = "drop"
+	rateLimitThrottle   = "throttle"
+	rateLimitDisconnect = "disconnect"
+)
+
+// maxThrottleDelay is the longest time to wait for a token, the message is dropped if it needs longer.
+var maxThrottleDelay = time.Second
+
+// defaultRateLimits are the limits of incoming messages from one peer.
+// The responses of our requests are throttled instead of dropped.
+var defaultRateLimits = map[MessageType]common.RateLimitConfig{
+	RoutingTableQuery:     {Rate: 1, Burst: 10, Action: rateLimitDrop},
+	RoutingTableResponse:  {Rate: 1, Burst: 10, Action: rateLimitDrop},
+	NewBlock:              {Rate: 50, Burst: 100, Action: rateLimitDrop},
+	NewBlockHash:          {Rate: 100, Burst: 200, Action: rateLimitDrop},
+	NewBlockRequest:       {Rate: 100, Burst: 200, Action: rateLimitDrop},
+	SyncBlockHashRequest:  {Rate: 50, Burst: 100, Action: rateLimitThrottle},
+	SyncBlockHashResponse: {Rate: 200, Burst: 400, Action: rateLimitThrottle},
+	SyncBlockRequest:      {Rate: 500, Burst: 1000, Action: rateLimitThrottle},
+	SyncBlockResponse:     {Rate: 1000, Burst: 2000, Action: rateLimitThrottle},
+	SyncHeight:            {Rate: 10, Burst: 20, Action: rateLimitDrop},
+	PublishTx:             {Rate: 2000, Burst: 4000, Action: rateLimitDrop},
+	NewTxHash:             {Rate: 100, Burst: 200, Action: rateLimitDrop},
+	TxRequest:             {Rate: 100, Burst: 200, Action: rateLimitDrop},
+	NewCompactBlock:       {Rate: 50, Burst: 100, Action: rateLimitDrop},
+	BlockTxRequest:        {Rate: 100, Burst: 200, Action: rateLimitDrop},
+	BlockTxResponse:       {Rate: 100, Burst: 200, Action: rateLimitThrottle},
+	SnapshotQuery:         {Rate: 1, Burst: 10, Action: rateLimitDrop},
+	SnapshotOffer:         {Rate: 1, Burst: 10, Action: rateLimitDrop},
+	SnapshotChunkRequest:  {Rate: 20, Burst: 40, Action: rateLimitDrop},
+	SnapshotChunkResponse: {Rate: 20, Burst: 40, Action: rateLimitThrottle},
+	TxResponse:            {Rate: 100, Burst: 200, Action: rateLimitThrottle},
+}
+
+// solicitedMessages are the responses of our requests, the peer isn't penalized when they are dropped by the limit.
+var solicitedMessages = map[MessageType]bool{
+	RoutingTableResponse:  true,
+	SyncBlockHashResponse: true,
+	SyncBlockResponse:     true,
+	BlockTxResponse:       true,
+	SnapshotOffer:         true,
+	SnapshotChunkResponse: true,
+	TxResponse:            true,
+}
+
+// parseRateLimits returns the default rate limits overridden by config, whose keys are case-insensitive message type names.
+// An override is merged with the default limit field by field, the fields not set are kept.
+func parseRateLimits(config map[string]*common.RateLimitConfig) (map[MessageType]common.RateLimitConfig, error) {
+	limits := make(map[MessageType]common.RateLimitConfig, len(defaultRateLimits))
+	for typ, l := range defaultRateLimits {
+		limits[typ] = l
+	}
+	for name, l := range config {
+		if l == nil {
+			continue
+		}
+		typ, ok := rateLimitType(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown message type of rate limit: %v", name)
+		}
+		limit := limits[typ]
+		if l.Rate != 0 {
+			limit.Rate = l.Rate
+		}
+		if l.Burst != 0 {
+			limit.Burst = l.Burst
+		}
+		if l.Action != "" {
+			limit.Action = strings.ToLower(l.Action)
+		}
+		if limit.Rate <= 0 {
+			return nil, fmt.Errorf("rate of rate limit should be positive: %v, message type: %v", limit.Rate, name)
+		}
+		if limit.Burst < 1 {
+			return nil, fmt.Errorf("burst of rate limit should be at least 1: %v, message type: %v", limit.Burst, name)
+		}
+		if limit.Action != rateLimitDrop && limit.Action != rateLimitThrottle && limit.Action != rateLimitDisconnect {
+			return nil, fmt.Errorf("invalid action of rate limit: %v, message type: %v", l.Action, name)
+		}
+		limits[typ] = limit
+	}
+	return limits, nil
+}
+
+// rateLimitType returns the message type of the case-insensitive name, which has a default rate limit.
+func rateLimitType(name string) (MessageType, bool) {
+	for typ := range defaultRateLimits {
+		if strings.EqualFold(typ.String(), name) {
+			return typ, true
+		}
+	}
+	return 0, false
+}
+
+// rateLimiter limits the incoming messages of a peer by token buckets of each message type.
+type rateLimiter struct {
+	limiters map[MessageType]*rate.Limiter
+	actions  map[MessageType]string
+}
+
+func newRateLimiter(limits map[MessageType]common.RateLimitConfig) *rateLimiter {
+	l := &rateLimiter{
+		limiters: make(map[MessageType]*rate.Limiter, len(limits)),
+		actions:  make(map[MessageType]string, len(limits)),
+	}
+	for typ, c := range limits {
+		if c.Rate <= 0 {
+			continue
+		}
+		l.limiters[typ] = rate.NewLimiter(rate.Limit(c.Rate), c.Burst)
+		l.actions[typ] = c.Action
+	}
+	return l
+}
+
+// limit takes a token for the message and returns the action applied to it.
+// The throttle action waits for the token, unless it needs too long or quit is closed, then the message is dropped.
+func (l *rateLimiter) limit(typ MessageType, quit <-chan struct{}) string {
+	lim, ok := l.limiters[typ]
+	if !ok || lim.Allow() {
+		return rateLimitPass
+	}
+	switch l.actions[typ] {
+	case rateLimitThrottle:
+		r := lim.Reserve()
+		if !r.OK() || r.Delay() > maxThrottleDelay {
+			r.Cancel()
+			return rateLimitDrop
+		}
+		select {
+		case <-time.After(r.Delay()):
+			return rateLimitThrottle
+		case <-quit:
+			r.Cancel()
+			return rateLimitDrop
+		}
+	case rateLimitDisconnect:
+		return rateLimitDisconnect
+	default:
+		return rateLimitDrop
+	}
+}
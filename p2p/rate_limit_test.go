@@ -0,0 +1,61 @@
+package p2p
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRateLimits(t *testing.T) {
+	limits, err := parseRateLimits(map[string]*common.RateLimitConfig{
+		"publishtx":  {Rate: 10, Burst: 1, Action: rateLimitDisconnect},
+		"newblock":   {Rate: 10, Burst: 1, Action: "Throttle"},
+		"SyncHeight": {Rate: 10},
+		"newtxhash":  {Burst: 100},
+		"txrequest":  nil,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, common.RateLimitConfig{Rate: 10, Burst: 1, Action: rateLimitDisconnect}, limits[PublishTx])
+	assert.Equal(t, common.RateLimitConfig{Rate: 10, Burst: 1, Action: rateLimitThrottle}, limits[NewBlock])
+	assert.Equal(t, common.RateLimitConfig{Rate: 10, Burst: defaultRateLimits[SyncHeight].Burst, Action: defaultRateLimits[SyncHeight].Action}, limits[SyncHeight], "only rate")
+	assert.Equal(t, common.RateLimitConfig{Rate: defaultRateLimits[NewTxHash].Rate, Burst: 100, Action: defaultRateLimits[NewTxHash].Action}, limits[NewTxHash], "only burst")
+	assert.Equal(t, defaultRateLimits[TxRequest], limits[TxRequest], "nil")
+	assert.Equal(t, defaultRateLimits[SyncBlockRequest], limits[SyncBlockRequest])
+	assert.Len(t, limits, len(defaultRateLimits))
+
+	invalid := []map[string]*common.RateLimitConfig{
+		{"publishtx": {Rate: -1}},
+		{"publishtx": {Burst: -1}},
+		{"publishtx": {Action: "ignore"}},
+		{"unknown": {Rate: 10, Burst: 1, Action: rateLimitDrop}},
+	}
+	for _, config := range invalid {
+		_, err := parseRateLimits(config)
+		assert.Error(t, err, "%v", config)
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	l := newRateLimiter(map[MessageType]common.RateLimitConfig{
+		PublishTx:        {Rate: 0.001, Burst: 1, Action: rateLimitDrop},
+		SyncBlockRequest: {Rate: 1000, Burst: 1, Action: rateLimitThrottle},
+		SyncHeight:       {Rate: 0.001, Burst: 1, Action: rateLimitThrottle},
+		NewBlock:         {Rate: 0.001, Burst: 1, Action: rateLimitDisconnect},
+	})
+	quit := make(chan struct{})
+
+	assert.Equal(t, rateLimitPass, l.limit(PublishTx, quit))
+	assert.Equal(t, rateLimitDrop, l.limit(PublishTx, quit))
+
+	assert.Equal(t, rateLimitPass, l.limit(SyncBlockRequest, quit))
+	assert.Equal(t, rateLimitThrottle, l.limit(SyncBlockRequest, quit))
+
+	assert.Equal(t, rateLimitPass, l.limit(SyncHeight, quit))
+	assert.Equal(t, rateLimitDrop, l.limit(SyncHeight, quit), "too long to throttle")
+
+	assert.Equal(t, rateLimitPass, l.limit(NewBlock, quit))
+	assert.Equal(t, rateLimitDisconnect, l.limit(NewBlock, quit))
+
+	assert.Equal(t, rateLimitPass, l.limit(RoutingTableQuery, quit), "no limit")
+}
@@ -0,0 +1,142 @@
+package p2p
+
+import (
+	"math"
+	"time"
+
+	"github.com/iost-official/go-iost/v3/ilog"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// PeerBehavior is the behavior of peer which changes its score.
+type PeerBehavior int
+
+// behaviors of peer
+const (
+	InvalidBlock PeerBehavior = iota
+	MalformedMessage
+	DuplicateMessage
+	SyncTimeout
+	UsefulResponse
+	RateLimited
+)
+
+var behaviorScores = map[PeerBehavior]float64{
+	InvalidBlock:     -50,
+	MalformedMessage: -20,
+	DuplicateMessage: -1,
+	SyncTimeout:      -5,
+	UsefulResponse:   1,
+	RateLimited:      -2,
+}
+
+// String returns a string representing the peer behavior.
+func (b PeerBehavior) String() string {
+	switch b {
+	case InvalidBlock:
+		return "InvalidBlock"
+	case MalformedMessage:
+		return "MalformedMessage"
+	case DuplicateMessage:
+		return "DuplicateMessage"
+	case SyncTimeout:
+		return "SyncTimeout"
+	case UsefulResponse:
+		return "UsefulResponse"
+	case RateLimited:
+		return "RateLimited"
+	default:
+		return "unknown_behavior"
+	}
+}
+
+var (
+	scoreHalfLife     = 10 * time.Minute
+	maxScore          = 100.0
+	banScoreThreshold = -100.0
+	banDuration       = 10 * time.Minute
+	maxBanShift       = 4
+)
+
+// peerScore is the reputation of a peer, it decays to zero as time goes by.
+type peerScore struct {
+	score       float64
+	updateTime  time.Time
+	bannedUntil time.Time
+	banCount    int
+}
+
+func (s *peerScore) decay(now time.Time) {
+	s.score *= math.Pow(0.5, float64(now.Sub(s.updateTime))/float64(scoreHalfLife))
+	s.updateTime = now
+}
+
+// ReportPeer changes the score of peer by its behavior. The peer whose score drops below the threshold is
+// disconnected and banned for a while, the ban duration doubles every time it is banned again.
+func (pm *PeerManager) ReportPeer(pid peer.ID, b PeerBehavior) {
+	peerBehaviorCounter.Add(1, map[string]string{"behavior": b.String()})
+
+	now := time.Now()
+	pm.scoreMutex.Lock()
+	s, ok := pm.scores[pid.String()]
+	if !ok {
+		s = &peerScore{updateTime: now}
+		pm.scores[pid.String()] = s
+	}
+	s.decay(now)
+	s.score = math.Min(s.score+behaviorScores[b], maxScore)
+	ban := s.score <= banScoreThreshold && !now.Before(s.bannedUntil)
+	if ban {
+		s.bannedUntil = now.Add(banDuration << min(s.banCount, maxBanShift))
+		s.banCount++
+		s.score = 0
+	}
+	bannedUntil := s.bannedUntil
+	pm.scoreMutex.Unlock()
+
+	if ban {
+		ilog.Warnf("peer score is too low, ban it until %v. pid=%v, behavior=%v", bannedUntil, pid.String(), b)
+		pm.RemoveNeighbor(pid)
+	}
+}
+
+func (pm *PeerManager) isBanned(pid peer.ID) bool {
+	pm.scoreMutex.Lock()
+	defer pm.scoreMutex.Unlock()
+
+	s, ok := pm.scores[pid.String()]
+	return ok && time.Now().Before(s.bannedUntil)
+}
+
+// peerScores returns the current scores of peers, and removes the ones decayed to nearly zero and not banned recently.
+func (pm *PeerManager) peerScores() map[string]float64 {
+	now := time.Now()
+	ret := make(map[string]float64)
+	pm.scoreMutex.Lock()
+	defer pm.scoreMutex.Unlock()
+
+	for pid, s := range pm.scores {
+		s.decay(now)
+		if math.Abs(s.score) < 1 && now.After(s.bannedUntil.Add(banDuration<<maxBanShift)) {
+			delete(pm.scores, pid)
+			continue
+		}
+		ret[pid] = s.score
+	}
+	return ret
+}
+
+// bannedPeers returns the peers banned for low score and when they are unbanned.
+func (pm *PeerManager) bannedPeers() map[string]time.Time {
+	now := time.Now()
+	ret := make(map[string]time.Time)
+	pm.scoreMutex.Lock()
+	defer pm.scoreMutex.Unlock()
+
+	for pid, s := range pm.scores {
+		if now.Before(s.bannedUntil) {
+			ret[pid] = s.bannedUntil
+		}
+	}
+	return ret
+}
@@ -0,0 +1,56 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	bloom "github.com/bits-and-blooms/bloom/v3"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportPeer(t *testing.T) {
+	pm := &PeerManager{
+		neighbors:     make(map[peer.ID]*Peer),
+		neighborCount: make(map[connDirection]int),
+		scores:        make(map[string]*peerScore),
+	}
+	pid, err := randomPID()
+	assert.Nil(t, err)
+
+	pm.ReportPeer(pid, UsefulResponse)
+	pm.ReportPeer(pid, InvalidBlock)
+	assert.False(t, pm.isBanned(pid))
+	assert.InDelta(t, -49, pm.peerScores()[pid.String()], 0.01)
+
+	pm.ReportPeer(pid, InvalidBlock)
+	pm.ReportPeer(pid, MalformedMessage)
+	assert.True(t, pm.isBanned(pid))
+	assert.Contains(t, pm.bannedPeers(), pid.String())
+	assert.Equal(t, 1, pm.scores[pid.String()].banCount)
+
+	s := pm.scores[pid.String()]
+	s.score = -80
+	s.updateTime = s.updateTime.Add(-scoreHalfLife)
+	assert.InDelta(t, -40, pm.peerScores()[pid.String()], 0.01)
+
+	s.bannedUntil = time.Now().Add(-time.Second)
+	assert.False(t, pm.isBanned(pid))
+}
+
+func TestIsResent(t *testing.T) {
+	receivedMsg, err := lru.New[string, struct{}](receivedMsgCacheSize)
+	assert.Nil(t, err)
+	p := &Peer{
+		recentMsg:   bloom.NewWithEstimates(bloomMaxItemCount, bloomErrRate),
+		receivedMsg: receivedMsg,
+	}
+	msg := newP2PMessage(1024, PublishTx, 1, 0, []byte("tx"))
+
+	// the messages we have sent to the neighbor aren't duplicates when it sends them back
+	p.recordMessage(msg)
+	assert.True(t, p.hasMessage(msg))
+	assert.False(t, p.isResent(msg))
+	assert.True(t, p.isResent(msg))
+}
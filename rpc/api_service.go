@@ -22,10 +22,12 @@ import (
 	"github.com/iost-official/go-iost/v3/chainbase"
 	"github.com/iost-official/go-iost/v3/common"
 	"github.com/iost-official/go-iost/v3/consensus/cverifier"
+	msgpb "github.com/iost-official/go-iost/v3/consensus/synchro/pb"
 	"github.com/iost-official/go-iost/v3/core/block"
 	"github.com/iost-official/go-iost/v3/core/blockcache"
 	"github.com/iost-official/go-iost/v3/core/event"
 	"github.com/iost-official/go-iost/v3/core/global"
+	"github.com/iost-official/go-iost/v3/core/merkletree"
 	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/core/txpool"
 	"github.com/iost-official/go-iost/v3/db"
@@ -35,6 +37,7 @@ import (
 	"github.com/iost-official/go-iost/v3/verifier"
 	"github.com/iost-official/go-iost/v3/vm/database"
 	"github.com/iost-official/go-iost/v3/vm/host"
+	"google.golang.org/protobuf/proto"
 )
 
 //go:generate mockgen --build_flags=--mod=mod -destination mock_rpc/mock_api.go -package main github.com/iost-official/go-iost/v3/rpc/pb ApiServiceServer
@@ -191,6 +194,24 @@ func (as *APIService) GetTxReceiptByTxHash(ctx context.Context, req *rpcpb.TxHas
 	return toPbTxReceipt(receipt), nil
 }
 
+// GetTxReceiptWithStateDiff returns transaction receipt with the state diff recorded by the node.
+func (as *APIService) GetTxReceiptWithStateDiff(ctx context.Context, req *rpcpb.TxHashRequest) (*rpcpb.TxReceipt, error) {
+	err := checkHashValid(req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+	txHashBytes := common.Base58Decode(req.GetHash())
+	receipt, err := as.blockchain.GetReceiptByTxHash(txHashBytes)
+	if err != nil {
+		return nil, err
+	}
+	receipt.StateDiff, err = as.blockchain.GetStateDiffByTxHash(txHashBytes)
+	if err != nil {
+		return nil, err
+	}
+	return toPbTxReceipt(receipt), nil
+}
+
 // GetBlockByHash returns block corresponding to the given hash.
 func (as *APIService) GetBlockByHash(ctx context.Context, req *rpcpb.GetBlockByHashRequest) (*rpcpb.BlockResponse, error) {
 	err := checkHashValid(req.GetHash())
@@ -371,7 +392,7 @@ func (as *APIService) GetTokenBalance(ctx context.Context, req *rpcpb.GetTokenBa
 		return nil, err
 	}
 
-	dbVisitor, _, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, blk, err := as.getStateDBVisitorOfRequest(req.ByLongestChain, req.GetBlockNumber())
 	if err != nil {
 		return nil, err
 	}
@@ -382,7 +403,7 @@ func (as *APIService) GetTokenBalance(ctx context.Context, req *rpcpb.GetTokenBa
 	balance := dbVisitor.TokenBalanceDecimal(req.GetToken(), req.GetAccount())
 	// pack frozen balance information
 	frozen := dbVisitor.AllFreezedTokenBalanceDecimal(req.GetToken(), req.GetAccount())
-	unfrozen, stillFrozen := as.getUnfrozenToken(frozen, req.ByLongestChain)
+	unfrozen, stillFrozen := getUnfrozenTokenAt(frozen, blk.Head.Time)
 	return &rpcpb.GetTokenBalanceResponse{
 		Balance:        balance.Add(unfrozen).Float64(),
 		FrozenBalances: stillFrozen,
@@ -453,6 +474,22 @@ func (as *APIService) GetContract(ctx context.Context, req *rpcpb.GetContractReq
 	return toPbContract(contract), nil
 }
 
+// GetContractHistory returns the version history of contract by contract ID.
+func (as *APIService) GetContractHistory(ctx context.Context, req *rpcpb.GetContractRequest) (*rpcpb.ContractHistory, error) {
+	err := checkIDValid(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	dbVisitor, _, err := as.getStateDBVisitor(req.ByLongestChain)
+	if err != nil {
+		return nil, err
+	}
+	if !dbVisitor.HasContract(req.GetId()) {
+		return nil, errors.New("contract not found")
+	}
+	return toPbContractHistory(dbVisitor.ContractHistory(req.GetId())), nil
+}
+
 // GetContractVote returns contract vote information by contract ID.
 func (as *APIService) GetContractVote(ctx context.Context, req *rpcpb.GetContractRequest) (*rpcpb.ContractVote, error) {
 	err := checkIDValid(req.GetId())
@@ -531,7 +568,7 @@ func (as *APIService) GetProducerVoteInfo(ctx context.Context, req *rpcpb.GetPro
 
 // GetContractStorage returns contract storage corresponding to the given key and field.
 func (as *APIService) GetContractStorage(ctx context.Context, req *rpcpb.GetContractStorageRequest) (*rpcpb.GetContractStorageResponse, error) {
-	dbVisitor, bcn, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, bcn, err := as.getStateDBVisitorOfRequest(req.ByLongestChain, req.GetBlockNumber())
 	if err != nil {
 		return nil, err
 	}
@@ -556,7 +593,7 @@ func (as *APIService) GetContractStorage(ctx context.Context, req *rpcpb.GetCont
 
 // GetBatchContractStorage returns contract storage corresponding to the given keys and fields.
 func (as *APIService) GetBatchContractStorage(ctx context.Context, req *rpcpb.GetBatchContractStorageRequest) (*rpcpb.GetBatchContractStorageResponse, error) {
-	dbVisitor, bcn, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, bcn, err := as.getStateDBVisitorOfRequest(req.ByLongestChain, req.GetBlockNumber())
 	if err != nil {
 		return nil, err
 	}
@@ -677,6 +714,12 @@ func (as *APIService) ListContractStorage(ctx context.Context, req *rpcpb.ListCo
 }
 
 func (as *APIService) tryTransaction(t *tx.Tx) (*tx.TxReceipt, error) {
+	receipt, _, err := as.estimateTransaction(t)
+	return receipt, err
+}
+
+// estimateTransaction executes the tx on the head state, and returns the receipt with the amounts of tokens charged against its amount limit.
+func (as *APIService) estimateTransaction(t *tx.Tx) (*tx.TxReceipt, map[string]*common.Decimal, error) {
 	topBlock := as.bc.Head()
 	blkHead := &block.BlockHead{
 		Version:    block.V1,
@@ -688,9 +731,9 @@ func (as *APIService) tryTransaction(t *tx.Tx) (*tx.TxReceipt, error) {
 	stateDB := as.stateDB.Fork()
 	ok := stateDB.Checkout(string(topBlock.HeadHash()))
 	if !ok {
-		return nil, fmt.Errorf("failed to checkout blockhash: %s", common.Base58Encode(topBlock.HeadHash()))
+		return nil, nil, fmt.Errorf("failed to checkout blockhash: %s", common.Base58Encode(topBlock.HeadHash()))
 	}
-	return v.Try(blkHead, stateDB, t, cverifier.TxExecTimeLimit)
+	return v.Estimate(blkHead, stateDB, t, cverifier.TxExecTimeLimit)
 }
 
 // SendTransaction sends a transaction to iserver.
@@ -726,16 +769,26 @@ func (as *APIService) SendTransaction(ctx context.Context, req *rpcpb.Transactio
 		return nil, err
 	}
 	if t.Time < time.Now().UnixNano() {
-		as.p2pService.Broadcast(t.Encode(), p2p.PublishTx, p2p.NormalMessage)
+		as.announceTx(t.Hash())
 	} else {
 		waitTime := time.Until(time.Unix(0, t.Time))
 		time.AfterFunc(waitTime, func() {
-			as.p2pService.Broadcast(t.Encode(), p2p.PublishTx, p2p.NormalMessage)
+			as.announceTx(t.Hash())
 		})
 	}
 	return ret, nil
 }
 
+// announceTx announces the tx hash to neighbors, who request the tx if they don't have it.
+func (as *APIService) announceTx(hash []byte) {
+	msg, err := proto.Marshal(&msgpb.TxHashes{Hashes: [][]byte{hash}})
+	if err != nil {
+		ilog.Errorf("Marshal tx hashes failed: %v", err)
+		return
+	}
+	as.p2pService.Broadcast(msg, p2p.NewTxHash, p2p.NormalMessage)
+}
+
 // ExecTransaction executes a transaction by the node and returns the receipt.
 func (as *APIService) ExecTransaction(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.TxReceipt, error) {
 	if !as.config.RPC.ExecTx {
@@ -749,17 +802,150 @@ func (as *APIService) ExecTransaction(ctx context.Context, req *rpcpb.Transactio
 	return toPbTxReceipt(receipt), nil
 }
 
+// gasLimitMargin is the ratio of the recommended gas limit to the estimated gas usage.
+const gasLimitMargin = 1.2
+
+// EstimateGas executes the tx on the head state with the max gas limit the publisher can afford,
+// and returns the gas usage with a recommended gas limit.
+func (as *APIService) EstimateGas(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.EstimateGasResponse, error) {
+	if !as.config.RPC.ExecTx {
+		return nil, errors.New("the node has't enabled this method")
+	}
+	t := toCoreTx(req)
+	headBlock := as.bc.Head()
+	dbVisitor, err := as.getStateDBVisitorByBlock(headBlock)
+	if err != nil {
+		return nil, err
+	}
+	currentGas := dbVisitor.TotalGasAtTime(t.Publisher, headBlock.Head.Time).Rescale(2)
+	t.GasLimit = tx.MaxGasLimit
+	if currentGas.Value < t.GasLimit {
+		t.GasLimit = currentGas.Value
+	}
+	receipt, amounts, err := as.estimateTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+	gasLimit := int64(float64(receipt.GasUsage) * gasLimitMargin)
+	if gasLimit < tx.MinGasLimit {
+		gasLimit = tx.MinGasLimit
+	}
+	if gasLimit > tx.MaxGasLimit {
+		gasLimit = tx.MaxGasLimit
+	}
+	return &rpcpb.EstimateGasResponse{
+		GasUsage:    float64(receipt.GasUsage) / 100,
+		RamUsage:    receipt.RAMUsage,
+		GasLimit:    float64(gasLimit) / 100,
+		AmountLimit: toPbChargedAmounts(amounts),
+		Receipt:     toPbTxReceipt(receipt),
+	}, nil
+}
+
+// toPbChargedAmounts converts the amounts charged against the amount limit of a tx, sorted by token.
+func toPbChargedAmounts(amounts map[string]*common.Decimal) []*rpcpb.AmountLimit {
+	tokens := make([]string, 0, len(amounts))
+	for token, amount := range amounts {
+		if amount.IsPositive() {
+			tokens = append(tokens, token)
+		}
+	}
+	sort.Strings(tokens)
+	ret := make([]*rpcpb.AmountLimit, 0, len(tokens))
+	for _, token := range tokens {
+		ret = append(ret, &rpcpb.AmountLimit{
+			Token: token,
+			Value: amounts[token].String(),
+		})
+	}
+	return ret
+}
+
+// TraceTransaction traces the contract calls of a tx. A tx in block is replayed on the state of its parent block,
+// otherwise the tx is executed on the head state.
+func (as *APIService) TraceTransaction(ctx context.Context, req *rpcpb.TraceTransactionRequest) (*rpcpb.TraceTransactionResponse, error) {
+	if !as.config.RPC.ExecTx {
+		return nil, errors.New("the node has't enabled this method")
+	}
+	var (
+		receipt *tx.TxReceipt
+		frames  []*host.CallFrame
+		err     error
+	)
+	if req.GetHash() != "" {
+		receipt, frames, err = as.traceTransactionInBlock(req.GetHash())
+	} else if req.GetTransaction() != nil {
+		receipt, frames, err = as.traceTransaction(toCoreTx(req.GetTransaction()))
+	} else {
+		return nil, errors.New("hash or transaction is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.TraceTransactionResponse{
+		Receipt: toPbTxReceipt(receipt),
+		Calls:   toPbCallFrames(frames),
+	}, nil
+}
+
+func (as *APIService) traceTransaction(t *tx.Tx) (*tx.TxReceipt, []*host.CallFrame, error) {
+	topBlock := as.bc.Head()
+	blkHead := &block.BlockHead{
+		Version:    block.V1,
+		ParentHash: topBlock.HeadHash(),
+		Number:     topBlock.Head.Number + 1,
+		Time:       time.Now().UnixNano(),
+	}
+	v := verifier.Executor{}
+	stateDB := as.stateDB.Fork()
+	if !stateDB.Checkout(string(topBlock.HeadHash())) {
+		return nil, nil, fmt.Errorf("failed to checkout blockhash: %s", common.Base58Encode(topBlock.HeadHash()))
+	}
+	return v.Trace(blkHead, stateDB, t, cverifier.TxExecTimeLimit)
+}
+
+func (as *APIService) traceTransactionInBlock(hash string) (*tx.TxReceipt, []*host.CallFrame, error) {
+	if err := checkHashValid(hash); err != nil {
+		return nil, nil, err
+	}
+	txHash := common.Base58Decode(hash)
+	number, err := as.blockchain.GetBlockNumberByTxHash(txHash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("tx not found in irreversible blocks: %v", err)
+	}
+	blk, err := as.blockchain.GetBlockByNumber(number)
+	if err != nil {
+		return nil, nil, err
+	}
+	parent, err := as.blockchain.GetBlockByNumber(number - 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	stateDB, err := as.getStateDBAt(parent)
+	if err != nil {
+		return nil, nil, err
+	}
+	limits := host.ReadBlockLimits(stateDB, blk.Head.Rules())
+	v := verifier.Executor{}
+	return v.TraceInBlock(blk, stateDB, txHash, &verifier.Config{
+		Timeout:     limits.BlockTimeLimit,
+		TxTimeLimit: limits.TxTimeLimit,
+	})
+}
+
 // Subscribe used for event.
 func (as *APIService) Subscribe(req *rpcpb.SubscribeRequest, res rpcpb.ApiService_SubscribeServer) error {
 	topics := make([]event.Topic, 0)
 	for _, t := range req.Topics {
 		topics = append(topics, event.Topic(t))
 	}
-	var filter *event.Meta
-	if req.GetFilter() != nil {
-		filter = &event.Meta{
-			ContractID: req.GetFilter().GetContractId(),
-		}
+	filter, err := toEventFilter(req.GetFilter())
+	if err != nil {
+		return err
+	}
+
+	if req.GetFromBlock() > 0 || req.GetCursor() > 0 {
+		return as.replayEvents(req, topics, filter, res)
 	}
 
 	ec := event.GetCollector()
@@ -791,6 +977,146 @@ func (as *APIService) Subscribe(req *rpcpb.SubscribeRequest, res rpcpb.ApiServic
 	}
 }
 
+// replayEvents sends events of irreversible blocks since the requested position,
+// then keeps sending events of new irreversible blocks.
+func (as *APIService) replayEvents(req *rpcpb.SubscribeRequest, topics []event.Topic, filter *event.Filter, res rpcpb.ApiService_SubscribeServer) error {
+	var sendReceipt, sendBlock bool
+	for _, t := range topics {
+		switch t {
+		case event.ContractReceipt:
+			sendReceipt = true
+		case event.LinkedRootChanged:
+			sendBlock = true
+		default:
+			return fmt.Errorf("topic %v can't be replayed", t)
+		}
+	}
+	number := req.GetFromBlock()
+	after := event.Cursor(req.GetCursor())
+	if after > 0 {
+		number = after.BlockNumber()
+	}
+
+	send := func(topic event.Topic, data string, t int64, cursor event.Cursor) error {
+		if after > 0 && cursor <= after {
+			return nil
+		}
+		return res.Send(&rpcpb.SubscribeResponse{
+			Event: &rpcpb.Event{
+				Topic: rpcpb.Event_Topic(topic),
+				Data:  data,
+				Time:  t,
+			},
+			Cursor: uint64(cursor),
+		})
+	}
+
+	// LinkedRootChanged events only wake up the loop, missing some of them is fine
+	ec := event.GetCollector()
+	id := time.Now().UnixNano()
+	ch := ec.Subscribe(id, []event.Topic{event.LinkedRootChanged}, nil)
+	defer ec.Unsubscribe(id, []event.Topic{event.LinkedRootChanged})
+
+	ticker := time.NewTicker(common.SlotInterval)
+	defer ticker.Stop()
+	timeup := time.NewTimer(time.Hour)
+	for {
+		for ; number < as.blockchain.Length(); number++ {
+			select {
+			case <-as.quitCh:
+				return nil
+			case <-res.Context().Done():
+				return res.Context().Err()
+			default:
+			}
+			blk, err := as.blockchain.GetBlockByNumber(number)
+			if err != nil {
+				return fmt.Errorf("get block %v failed: %v", number, err)
+			}
+			index := 0
+			for i, r := range blk.Receipts {
+				for _, rec := range r.Receipts {
+					if index >= event.MaxCursorIndex {
+						return fmt.Errorf("too many receipts in block %v", number)
+					}
+					cursor := event.NewCursor(number, index)
+					index++
+					if !sendReceipt {
+						continue
+					}
+					e := event.NewEvent(event.ContractReceipt, rec.Content)
+					if filter != nil && !filter.Match(e, receiptEventMeta(blk.Txs[i], r, rec)) {
+						continue
+					}
+					if err := send(event.ContractReceipt, rec.Content, blk.Head.Time, cursor); err != nil {
+						ilog.Errorf("stream send failed. err=%v", err)
+						return err
+					}
+				}
+			}
+			if sendBlock {
+				data, err := json.Marshal(event.NewBlockData(blk))
+				if err != nil {
+					return err
+				}
+				if err := send(event.LinkedRootChanged, string(data), blk.Head.Time, event.NewCursor(number, index)); err != nil {
+					ilog.Errorf("stream send failed. err=%v", err)
+					return err
+				}
+			}
+		}
+		select {
+		case <-timeup.C:
+			return nil
+		case <-as.quitCh:
+			return nil
+		case <-res.Context().Done():
+			return res.Context().Err()
+		case <-ch:
+		case <-ticker.C:
+		}
+	}
+}
+
+func toEventFilter(f *rpcpb.SubscribeRequest_Filter) (*event.Filter, error) {
+	if f == nil {
+		return nil, nil
+	}
+	filter := &event.Filter{
+		ContractID: f.GetContractId(),
+		ActionName: f.GetActionName(),
+		Signer:     f.GetSigner(),
+	}
+	for _, c := range f.GetStatusCodes() {
+		filter.StatusCodes = append(filter.StatusCodes, tx.StatusCode(c))
+	}
+	for _, p := range f.GetDataPredicates() {
+		predicate, err := event.ParsePredicate(p)
+		if err != nil {
+			return nil, err
+		}
+		filter.Predicates = append(filter.Predicates, predicate)
+	}
+	return filter, nil
+}
+
+func receiptEventMeta(t *tx.Tx, r *tx.TxReceipt, rec *tx.Receipt) *event.Meta {
+	meta := &event.Meta{
+		Publisher:  t.Publisher,
+		Signers:    []string{t.Publisher},
+		StatusCode: r.Status.Code,
+	}
+	funcName := strings.SplitN(rec.FuncName, "/", 2)
+	meta.ContractID = funcName[0]
+	if len(funcName) > 1 {
+		meta.ActionName = funcName[1]
+	}
+	for _, s := range t.Signers {
+		meta.Signers = append(meta.Signers, strings.Split(s, "@")[0])
+	}
+	return meta
+}
+
 // GetVoterBonus returns the bonus a voter can claim.
 func (as *APIService) GetVoterBonus(ctx context.Context, req *rpcpb.GetAccountRequest) (*rpcpb.VoterBonus, error) {
 	err := checkIDValid(req.GetName())
@@ -1008,6 +1334,53 @@ func (as *APIService) getStateDBVisitorByBlock(bcn *blockcache.BlockCacheNode) (
 	return
 }
 
+// getStateDBVisitorAt returns the state of the block number on the longest chain.
+// The state of blocks before the last irreversible block is read from archive.
+func (as *APIService) getStateDBVisitorAt(number int64) (*database.Visitor, *block.Block, error) {
+	blk, err := as.blockchain.GetBlockByNumber(number)
+	if err != nil {
+		blk, err = as.bc.GetBlockByNumber(number)
+		if err != nil {
+			return nil, nil, fmt.Errorf("block %v not found: %v", number, err)
+		}
+	}
+	stateDB, err := as.getStateDBAt(blk)
+	if err != nil {
+		return nil, nil, err
+	}
+	return database.NewVisitor(0, stateDB, blk.Head.Rules()), blk, nil
+}
+
+// getStateDBAt returns the state of the block, which is read from archive if it has been flushed.
+func (as *APIService) getStateDBAt(blk *block.Block) (db.MVCCDB, error) {
+	stateDB := as.stateDB.Fork()
+	if stateDB.Checkout(string(blk.HeadHash())) {
+		return stateDB, nil
+	}
+	archive, ok := as.stateDB.(db.Archive)
+	if !ok {
+		return nil, db.ErrArchiveDisabled
+	}
+	stateDB, err := archive.StateAt(string(blk.HeadHash()))
+	if err != nil {
+		return nil, fmt.Errorf("get state of block %v failed: %v", blk.Head.Number, err)
+	}
+	return stateDB, nil
+}
+
+// getStateDBVisitorOfRequest returns the state of the block number if it is positive,
+// otherwise returns the state of the head or the last irreversible block.
+func (as *APIService) getStateDBVisitorOfRequest(longestChain bool, number int64) (*database.Visitor, *block.Block, error) {
+	if number > 0 {
+		return as.getStateDBVisitorAt(number)
+	}
+	dbVisitor, bcn, err := as.getStateDBVisitor(longestChain)
+	if err != nil {
+		return nil, nil, err
+	}
+	return dbVisitor, bcn.Block, nil
+}
+
 func (as *APIService) getStateDBVisitor(longestChain bool) (*database.Visitor, *blockcache.BlockCacheNode, error) {
 	var err error
 	var db *database.Visitor
@@ -1036,6 +1409,10 @@ func (as *APIService) getUnfrozenToken(frozens []database.FreezeItemDecimal, lon
 	} else {
 		blockTime = as.bc.LinkedRoot().Head.Time
 	}
+	return getUnfrozenTokenAt(frozens, blockTime)
+}
+
+func getUnfrozenTokenAt(frozens []database.FreezeItemDecimal, blockTime int64) (*common.Decimal, []*rpcpb.FrozenBalance) {
 	var unfrozen *common.Decimal = common.NewDecimalZero()
 	var stillFrozen []*rpcpb.FrozenBalance
 	for _, f := range frozens {
@@ -1132,3 +1509,145 @@ func formatInternalValue(value any) (data string, err error) {
 	}
 	return
 }
+
+func (as *APIService) getIrreversibleBlockByTxHash(hash string) (*block.Block, []byte, error) {
+	err := checkHashValid(hash)
+	if err != nil {
+		return nil, nil, err
+	}
+	txHashBytes := common.Base58Decode(hash)
+	blockNumber, err := as.blockchain.GetBlockNumberByTxHash(txHashBytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("tx %v is not in irreversible blocks: %v", hash, err)
+	}
+	blk, err := as.blockchain.GetBlockByNumber(blockNumber)
+	if err != nil {
+		return nil, nil, err
+	}
+	return blk, txHashBytes, nil
+}
+
+func toPbMerkleProof(blk *block.Block, root, leaf []byte, index int32, siblings [][]byte) *rpcpb.MerkleProofResponse {
+	res := &rpcpb.MerkleProofResponse{
+		BlockNumber: blk.Head.Number,
+		BlockHash:   common.Base58Encode(blk.HeadHash()),
+		Root:        common.Base58Encode(root),
+		Leaf:        common.Base58Encode(leaf),
+		Index:       int64(index),
+		Siblings:    make([]string, 0, len(siblings)),
+	}
+	for _, s := range siblings {
+		res.Siblings = append(res.Siblings, common.Base58Encode(s))
+	}
+	return res
+}
+
+// GetTxProof returns the merkle proof of the transaction against the tx merkle root of its block.
+// Only transactions in irreversible blocks are supported.
+func (as *APIService) GetTxProof(ctx context.Context, req *rpcpb.TxHashRequest) (*rpcpb.MerkleProofResponse, error) {
+	blk, txHashBytes, err := as.getIrreversibleBlockByTxHash(req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([][]byte, 0, len(blk.Txs))
+	for _, t := range blk.Txs {
+		hashes = append(hashes, t.Hash())
+	}
+	m := merkletree.MerkleTree{}
+	m.Build(hashes)
+	index, siblings, err := m.MerkleProof(txHashBytes)
+	if err != nil {
+		return nil, err
+	}
+	return toPbMerkleProof(blk, blk.Head.TxMerkleHash, txHashBytes, index, siblings), nil
+}
+
+// GetReceiptProof returns the merkle proof of the transaction receipt against the receipt merkle root of its block.
+// Only transactions in irreversible blocks are supported.
+func (as *APIService) GetReceiptProof(ctx context.Context, req *rpcpb.TxHashRequest) (*rpcpb.MerkleProofResponse, error) {
+	blk, txHashBytes, err := as.getIrreversibleBlockByTxHash(req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+	m := merkletree.TXRMerkleTree{}
+	m.Build(blk.Receipts)
+	receiptHash, index, siblings, err := m.MerkleProof(txHashBytes)
+	if err != nil {
+		return nil, err
+	}
+	return toPbMerkleProof(blk, blk.Head.TxReceiptMerkleHash, receiptHash, index, siblings), nil
+}
+
+// GetTxPoolStats returns the pending txs of an account in the tx pool.
+func (as *APIService) GetTxPoolStats(ctx context.Context, req *rpcpb.GetTxPoolStatsRequest) (*rpcpb.TxPoolStatsResponse, error) {
+	if req.GetAccount() == "" {
+		return nil, errors.New("account name is required")
+	}
+	pendingTx, _ := as.txpool.PendingTx()
+	stats := as.txpool.AccountStats(req.GetAccount())
+	res := &rpcpb.TxPoolStatsResponse{
+		TxPoolSize:  int64(pendingTx.Size()),
+		Pending:     int64(stats.Pending),
+		Quota:       int64(stats.Quota),
+		MinGasRatio: float64(stats.MinGasRatio) / 100,
+		MaxGasRatio: float64(stats.MaxGasRatio) / 100,
+	}
+	for _, hash := range stats.TxHashes {
+		res.TxHashes = append(res.TxHashes, common.Base58Encode(hash))
+	}
+	return res, nil
+}
+
+// ListPendingTransactions returns pending txs in the tx pool that match the request, in order of priority.
+func (as *APIService) ListPendingTransactions(ctx context.Context, req *rpcpb.ListPendingTransactionsRequest) (*rpcpb.ListPendingTransactionsResponse, error) {
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = 100
+	}
+	res := &rpcpb.ListPendingTransactionsResponse{
+		Transactions: make([]*rpcpb.Transaction, 0),
+	}
+	pendingTx, _ := as.txpool.PendingTx()
+	iter := pendingTx.Iter()
+	for t, ok := iter.Next(); ok && int64(len(res.Transactions)) < limit; t, ok = iter.Next() {
+		if req.GetPublisher() != "" && t.Publisher != req.GetPublisher() {
+			continue
+		}
+		if !matchActions(t, req.GetContract(), req.GetActionName()) {
+			continue
+		}
+		res.Transactions = append(res.Transactions, toPbTx(t, nil))
+	}
+	return res, nil
+}
+
+// matchActions returns whether any action of the tx has the contract and action name, empty matches any.
+func matchActions(t *tx.Tx, contract, actionName string) bool {
+	if contract == "" && actionName == "" {
+		return true
+	}
+	for _, a := range t.Actions {
+		if (contract == "" || a.Contract == contract) && (actionName == "" || a.ActionName == actionName) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetTxPoolStatus returns the status of the tx pool.
+func (as *APIService) GetTxPoolStatus(context.Context, *rpcpb.EmptyRequest) (*rpcpb.TxPoolStatusResponse, error) {
+	status := as.txpool.Status()
+	res := &rpcpb.TxPoolStatusResponse{
+		TxPoolSize:   int64(status.Size),
+		Capacity:     int64(status.Capacity),
+		OldestTxTime: status.OldestTxTime,
+		DropCounts:   make(map[string]int64),
+	}
+	if status.OldestTxTime > 0 {
+		res.OldestTxAge = time.Now().UnixNano() - status.OldestTxTime
+	}
+	for reason, n := range status.Drops {
+		res.DropCounts[string(reason)] = n
+	}
+	return res, nil
+}
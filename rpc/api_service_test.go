@@ -0,0 +1,97 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iost-official/go-iost/v3/account"
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/core/blockcache"
+	"github.com/iost-official/go-iost/v3/core/blockcache/mock"
+	"github.com/iost-official/go-iost/v3/core/tx"
+	"github.com/iost-official/go-iost/v3/crypto"
+	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+	"github.com/iost-official/go-iost/v3/sdk"
+	"github.com/iost-official/go-iost/v3/verifier"
+	"github.com/iost-official/go-iost/v3/vm/native"
+	"github.com/stretchr/testify/assert"
+)
+
+func newEstimateService(t *testing.T, s *verifier.Simulator) *APIService {
+	ctrl := gomock.NewController(t)
+	head := &blockcache.BlockCacheNode{Block: &block.Block{Head: s.Head}}
+	bc := mock.NewMockBlockCache(ctrl)
+	bc.EXPECT().Head().Return(head).AnyTimes()
+	s.Mvcc.Commit(string(head.HeadHash()))
+	return &APIService{
+		bc:      bc,
+		stateDB: s.Mvcc,
+		config:  &common.Config{RPC: &common.RPCConfig{ExecTx: true}},
+	}
+}
+
+func newEstimateRequest(kp *account.KeyPair, action *rpcpb.Action) *rpcpb.TransactionRequest {
+	now := time.Now().UnixNano()
+	req := &rpcpb.TransactionRequest{
+		Time:        now,
+		Expiration:  now + int64(time.Minute),
+		GasRatio:    1,
+		GasLimit:    sdk.DefaultGasLimit,
+		Actions:     []*rpcpb.Action{action},
+		AmountLimit: []*rpcpb.AmountLimit{{Token: "*", Value: "unlimited"}},
+		ChainId:     tx.ChainID,
+		Publisher:   "user_0",
+	}
+	req.PublisherSigs = []*rpcpb.Signature{sdk.GetSignatureOfTx(req, kp, true)}
+	return req
+}
+
+func TestEstimateGas(t *testing.T) {
+	s := verifier.NewSimulator()
+	defer s.Clear()
+	s.SetContract(native.SystemABI())
+	kp, err := account.NewKeyPair(nil, crypto.Secp256k1)
+	assert.NoError(t, err)
+	s.SetAccount(account.NewAccountFromKeys("user_0", kp.ReadablePubkey(), kp.ReadablePubkey()))
+	s.SetGas("user_0", 1e8)
+	s.Visitor.Commit()
+	as := newEstimateService(t, s)
+
+	t.Run("success", func(t *testing.T) {
+		resp, err := as.EstimateGas(context.Background(), newEstimateRequest(kp, &rpcpb.Action{
+			Contract:   "system.iost",
+			ActionName: "receipt",
+			Data:       `["hello"]`,
+		}))
+		assert.NoError(t, err)
+		assert.Equal(t, rpcpb.TxReceipt_SUCCESS, resp.Receipt.StatusCode)
+		assert.True(t, resp.GasUsage > 0)
+		expected := resp.GasUsage * gasLimitMargin
+		if expected < float64(tx.MinGasLimit)/100 {
+			expected = float64(tx.MinGasLimit) / 100
+		}
+		assert.InDelta(t, expected, resp.GasLimit, 0.01)
+		assert.Empty(t, resp.AmountLimit)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		resp, err := as.EstimateGas(context.Background(), newEstimateRequest(kp, &rpcpb.Action{
+			Contract:   "system.iost",
+			ActionName: "nonexistent",
+			Data:       `[]`,
+		}))
+		assert.NoError(t, err)
+		assert.NotEqual(t, rpcpb.TxReceipt_SUCCESS, resp.Receipt.StatusCode)
+		assert.NotEmpty(t, resp.Receipt.Message)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		disabled := *as
+		disabled.config = &common.Config{RPC: &common.RPCConfig{}}
+		_, err := disabled.EstimateGas(context.Background(), newEstimateRequest(kp, &rpcpb.Action{}))
+		assert.Error(t, err)
+	})
+}
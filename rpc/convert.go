@@ -8,6 +8,8 @@ import (
 	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/crypto"
 	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+	"github.com/iost-official/go-iost/v3/vm/database"
+	"github.com/iost-official/go-iost/v3/vm/host"
 )
 
 func toPbAction(a *tx.Action) *rpcpb.Action {
@@ -36,6 +38,33 @@ func toPbTxReceipt(tr *tx.TxReceipt) *rpcpb.TxReceipt {
 			Content:  r.Content,
 		})
 	}
+	for _, c := range tr.StateDiff {
+		ret.StateDiff = append(ret.StateDiff, &rpcpb.TxReceipt_StateChange{
+			Contract: c.Contract,
+			Key:      c.Key,
+			Field:    c.Field,
+			OldValue: c.OldValue,
+			NewValue: c.NewValue,
+		})
+	}
+	return ret
+}
+
+func toPbCallFrames(frames []*host.CallFrame) []*rpcpb.CallFrame {
+	ret := make([]*rpcpb.CallFrame, 0, len(frames))
+	for _, f := range frames {
+		ret = append(ret, &rpcpb.CallFrame{
+			Contract: f.Contract,
+			Api:      f.API,
+			Args:     f.Args,
+			Returns:  f.Returns,
+			Error:    f.Error,
+			Gas:      f.Gas,
+			RamUsage: f.RAMUsage,
+			Logs:     f.Logs,
+			Calls:    toPbCallFrames(f.Calls),
+		})
+	}
 	return ret
 }
 
@@ -141,8 +170,14 @@ func toPbContract(c *contract.Contract) *rpcpb.Contract {
 		OrigCode: c.OrigCode,
 		Language: c.Info.Lang,
 		Version:  c.Info.Version,
+		Abis:     toPbContractABIs(c.Info.Abi),
 	}
-	for _, abi := range c.Info.Abi {
+	return ret
+}
+
+func toPbContractABIs(abis []*contract.ABI) []*rpcpb.Contract_ABI {
+	ret := make([]*rpcpb.Contract_ABI, 0, len(abis))
+	for _, abi := range abis {
 		pbABI := &rpcpb.Contract_ABI{
 			Name: abi.Name,
 			Args: abi.Args,
@@ -150,7 +185,23 @@ func toPbContract(c *contract.Contract) *rpcpb.Contract {
 		for _, al := range abi.AmountLimit {
 			pbABI.AmountLimit = append(pbABI.AmountLimit, toPbAmountLimit(al))
 		}
-		ret.Abis = append(ret.Abis, pbABI)
+		ret = append(ret, pbABI)
+	}
+	return ret
+}
+
+func toPbContractHistory(history []*database.ContractVersion) *rpcpb.ContractHistory {
+	ret := &rpcpb.ContractHistory{}
+	for _, v := range history {
+		ret.Versions = append(ret.Versions, &rpcpb.ContractHistory_Version{
+			Version:     v.Version,
+			CodeHash:    v.CodeHash,
+			Language:    v.Lang,
+			Abis:        toPbContractABIs(v.Abi),
+			BlockNumber: v.BlockNumber,
+			TxHash:      v.TxHash,
+			Updater:     v.Updater,
+		})
 	}
 	return ret
 }
@@ -35,6 +35,21 @@ func (m *MockApiServiceServer) EXPECT() *MockApiServiceServerMockRecorder {
 	return m.recorder
 }
 
+// EstimateGas mocks base method.
+func (m *MockApiServiceServer) EstimateGas(arg0 context.Context, arg1 *rpcpb.TransactionRequest) (*rpcpb.EstimateGasResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateGas", arg0, arg1)
+	ret0, _ := ret[0].(*rpcpb.EstimateGasResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateGas indicates an expected call of EstimateGas.
+func (mr *MockApiServiceServerMockRecorder) EstimateGas(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGas", reflect.TypeOf((*MockApiServiceServer)(nil).EstimateGas), arg0, arg1)
+}
+
 // ExecTransaction mocks base method.
 func (m *MockApiServiceServer) ExecTransaction(arg0 context.Context, arg1 *rpcpb.TransactionRequest) (*rpcpb.TxReceipt, error) {
 	m.ctrl.T.Helper()
@@ -185,6 +200,21 @@ func (mr *MockApiServiceServerMockRecorder) GetContract(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContract", reflect.TypeOf((*MockApiServiceServer)(nil).GetContract), arg0, arg1)
 }
 
+// GetContractHistory mocks base method.
+func (m *MockApiServiceServer) GetContractHistory(arg0 context.Context, arg1 *rpcpb.GetContractRequest) (*rpcpb.ContractHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContractHistory", arg0, arg1)
+	ret0, _ := ret[0].(*rpcpb.ContractHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContractHistory indicates an expected call of GetContractHistory.
+func (mr *MockApiServiceServerMockRecorder) GetContractHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractHistory", reflect.TypeOf((*MockApiServiceServer)(nil).GetContractHistory), arg0, arg1)
+}
+
 // GetContractStorage mocks base method.
 func (m *MockApiServiceServer) GetContractStorage(arg0 context.Context, arg1 *rpcpb.GetContractStorageRequest) (*rpcpb.GetContractStorageResponse, error) {
 	m.ctrl.T.Helper()
@@ -305,6 +335,21 @@ func (mr *MockApiServiceServerMockRecorder) GetRawBlockByNumber(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRawBlockByNumber", reflect.TypeOf((*MockApiServiceServer)(nil).GetRawBlockByNumber), arg0, arg1)
 }
 
+// GetReceiptProof mocks base method.
+func (m *MockApiServiceServer) GetReceiptProof(arg0 context.Context, arg1 *rpcpb.TxHashRequest) (*rpcpb.MerkleProofResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceiptProof", arg0, arg1)
+	ret0, _ := ret[0].(*rpcpb.MerkleProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceiptProof indicates an expected call of GetReceiptProof.
+func (mr *MockApiServiceServerMockRecorder) GetReceiptProof(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceiptProof", reflect.TypeOf((*MockApiServiceServer)(nil).GetReceiptProof), arg0, arg1)
+}
+
 // GetToken721Balance mocks base method.
 func (m *MockApiServiceServer) GetToken721Balance(arg0 context.Context, arg1 *rpcpb.GetTokenBalanceRequest) (*rpcpb.GetToken721BalanceResponse, error) {
 	m.ctrl.T.Helper()
@@ -395,6 +440,51 @@ func (mr *MockApiServiceServerMockRecorder) GetTxByHash(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxByHash", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxByHash), arg0, arg1)
 }
 
+// GetTxPoolStats mocks base method.
+func (m *MockApiServiceServer) GetTxPoolStats(arg0 context.Context, arg1 *rpcpb.GetTxPoolStatsRequest) (*rpcpb.TxPoolStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTxPoolStats", arg0, arg1)
+	ret0, _ := ret[0].(*rpcpb.TxPoolStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxPoolStats indicates an expected call of GetTxPoolStats.
+func (mr *MockApiServiceServerMockRecorder) GetTxPoolStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxPoolStats", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxPoolStats), arg0, arg1)
+}
+
+// GetTxPoolStatus mocks base method.
+func (m *MockApiServiceServer) GetTxPoolStatus(arg0 context.Context, arg1 *rpcpb.EmptyRequest) (*rpcpb.TxPoolStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTxPoolStatus", arg0, arg1)
+	ret0, _ := ret[0].(*rpcpb.TxPoolStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxPoolStatus indicates an expected call of GetTxPoolStatus.
+func (mr *MockApiServiceServerMockRecorder) GetTxPoolStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxPoolStatus", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxPoolStatus), arg0, arg1)
+}
+
+// GetTxProof mocks base method.
+func (m *MockApiServiceServer) GetTxProof(arg0 context.Context, arg1 *rpcpb.TxHashRequest) (*rpcpb.MerkleProofResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTxProof", arg0, arg1)
+	ret0, _ := ret[0].(*rpcpb.MerkleProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxProof indicates an expected call of GetTxProof.
+func (mr *MockApiServiceServerMockRecorder) GetTxProof(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxProof", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxProof), arg0, arg1)
+}
+
 // GetTxReceiptByTxHash mocks base method.
 func (m *MockApiServiceServer) GetTxReceiptByTxHash(arg0 context.Context, arg1 *rpcpb.TxHashRequest) (*rpcpb.TxReceipt, error) {
 	m.ctrl.T.Helper()
@@ -410,6 +500,21 @@ func (mr *MockApiServiceServerMockRecorder) GetTxReceiptByTxHash(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxReceiptByTxHash", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxReceiptByTxHash), arg0, arg1)
 }
 
+// GetTxReceiptWithStateDiff mocks base method.
+func (m *MockApiServiceServer) GetTxReceiptWithStateDiff(arg0 context.Context, arg1 *rpcpb.TxHashRequest) (*rpcpb.TxReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTxReceiptWithStateDiff", arg0, arg1)
+	ret0, _ := ret[0].(*rpcpb.TxReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxReceiptWithStateDiff indicates an expected call of GetTxReceiptWithStateDiff.
+func (mr *MockApiServiceServerMockRecorder) GetTxReceiptWithStateDiff(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxReceiptWithStateDiff", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxReceiptWithStateDiff), arg0, arg1)
+}
+
 // GetVoterBonus mocks base method.
 func (m *MockApiServiceServer) GetVoterBonus(arg0 context.Context, arg1 *rpcpb.GetAccountRequest) (*rpcpb.VoterBonus, error) {
 	m.ctrl.T.Helper()
@@ -440,6 +545,21 @@ func (mr *MockApiServiceServerMockRecorder) ListContractStorage(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContractStorage", reflect.TypeOf((*MockApiServiceServer)(nil).ListContractStorage), arg0, arg1)
 }
 
+// ListPendingTransactions mocks base method.
+func (m *MockApiServiceServer) ListPendingTransactions(arg0 context.Context, arg1 *rpcpb.ListPendingTransactionsRequest) (*rpcpb.ListPendingTransactionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingTransactions", arg0, arg1)
+	ret0, _ := ret[0].(*rpcpb.ListPendingTransactionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingTransactions indicates an expected call of ListPendingTransactions.
+func (mr *MockApiServiceServerMockRecorder) ListPendingTransactions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingTransactions", reflect.TypeOf((*MockApiServiceServer)(nil).ListPendingTransactions), arg0, arg1)
+}
+
 // SendTransaction mocks base method.
 func (m *MockApiServiceServer) SendTransaction(arg0 context.Context, arg1 *rpcpb.TransactionRequest) (*rpcpb.SendTransactionResponse, error) {
 	m.ctrl.T.Helper()
@@ -468,3 +588,18 @@ func (mr *MockApiServiceServerMockRecorder) Subscribe(arg0, arg1 interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockApiServiceServer)(nil).Subscribe), arg0, arg1)
 }
+
+// TraceTransaction mocks base method.
+func (m *MockApiServiceServer) TraceTransaction(arg0 context.Context, arg1 *rpcpb.TraceTransactionRequest) (*rpcpb.TraceTransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TraceTransaction", arg0, arg1)
+	ret0, _ := ret[0].(*rpcpb.TraceTransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TraceTransaction indicates an expected call of TraceTransaction.
+func (mr *MockApiServiceServerMockRecorder) TraceTransaction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceTransaction", reflect.TypeOf((*MockApiServiceServer)(nil).TraceTransaction), arg0, arg1)
+}
@@ -19,35 +19,44 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	ApiService_GetNodeInfo_FullMethodName              = "/rpcpb.ApiService/GetNodeInfo"
-	ApiService_GetChainInfo_FullMethodName             = "/rpcpb.ApiService/GetChainInfo"
-	ApiService_GetRAMInfo_FullMethodName               = "/rpcpb.ApiService/GetRAMInfo"
-	ApiService_GetTxByHash_FullMethodName              = "/rpcpb.ApiService/GetTxByHash"
-	ApiService_GetTxReceiptByTxHash_FullMethodName     = "/rpcpb.ApiService/GetTxReceiptByTxHash"
-	ApiService_GetBlockByHash_FullMethodName           = "/rpcpb.ApiService/GetBlockByHash"
-	ApiService_GetBlockByNumber_FullMethodName         = "/rpcpb.ApiService/GetBlockByNumber"
-	ApiService_GetRawBlockByNumber_FullMethodName      = "/rpcpb.ApiService/GetRawBlockByNumber"
-	ApiService_GetBlockHeaderByRange_FullMethodName    = "/rpcpb.ApiService/GetBlockHeaderByRange"
-	ApiService_GetAccount_FullMethodName               = "/rpcpb.ApiService/GetAccount"
-	ApiService_GetTokenBalance_FullMethodName          = "/rpcpb.ApiService/GetTokenBalance"
-	ApiService_GetToken721Balance_FullMethodName       = "/rpcpb.ApiService/GetToken721Balance"
-	ApiService_GetToken721Metadata_FullMethodName      = "/rpcpb.ApiService/GetToken721Metadata"
-	ApiService_GetToken721Owner_FullMethodName         = "/rpcpb.ApiService/GetToken721Owner"
-	ApiService_GetGasRatio_FullMethodName              = "/rpcpb.ApiService/GetGasRatio"
-	ApiService_GetProducerVoteInfo_FullMethodName      = "/rpcpb.ApiService/GetProducerVoteInfo"
-	ApiService_GetContract_FullMethodName              = "/rpcpb.ApiService/GetContract"
-	ApiService_GetContractVote_FullMethodName          = "/rpcpb.ApiService/GetContractVote"
-	ApiService_GetContractStorage_FullMethodName       = "/rpcpb.ApiService/GetContractStorage"
-	ApiService_GetBatchContractStorage_FullMethodName  = "/rpcpb.ApiService/GetBatchContractStorage"
-	ApiService_ListContractStorage_FullMethodName      = "/rpcpb.ApiService/ListContractStorage"
-	ApiService_GetContractStorageFields_FullMethodName = "/rpcpb.ApiService/GetContractStorageFields"
-	ApiService_SendTransaction_FullMethodName          = "/rpcpb.ApiService/SendTransaction"
-	ApiService_ExecTransaction_FullMethodName          = "/rpcpb.ApiService/ExecTransaction"
-	ApiService_Subscribe_FullMethodName                = "/rpcpb.ApiService/Subscribe"
-	ApiService_GetVoterBonus_FullMethodName            = "/rpcpb.ApiService/GetVoterBonus"
-	ApiService_GetCandidateBonus_FullMethodName        = "/rpcpb.ApiService/GetCandidateBonus"
-	ApiService_GetTokenInfo_FullMethodName             = "/rpcpb.ApiService/GetTokenInfo"
-	ApiService_GetBlockTxsByContract_FullMethodName    = "/rpcpb.ApiService/GetBlockTxsByContract"
+	ApiService_GetNodeInfo_FullMethodName               = "/rpcpb.ApiService/GetNodeInfo"
+	ApiService_GetChainInfo_FullMethodName              = "/rpcpb.ApiService/GetChainInfo"
+	ApiService_GetRAMInfo_FullMethodName                = "/rpcpb.ApiService/GetRAMInfo"
+	ApiService_GetTxByHash_FullMethodName               = "/rpcpb.ApiService/GetTxByHash"
+	ApiService_GetTxReceiptByTxHash_FullMethodName      = "/rpcpb.ApiService/GetTxReceiptByTxHash"
+	ApiService_GetTxReceiptWithStateDiff_FullMethodName = "/rpcpb.ApiService/GetTxReceiptWithStateDiff"
+	ApiService_GetBlockByHash_FullMethodName            = "/rpcpb.ApiService/GetBlockByHash"
+	ApiService_GetBlockByNumber_FullMethodName          = "/rpcpb.ApiService/GetBlockByNumber"
+	ApiService_GetRawBlockByNumber_FullMethodName       = "/rpcpb.ApiService/GetRawBlockByNumber"
+	ApiService_GetBlockHeaderByRange_FullMethodName     = "/rpcpb.ApiService/GetBlockHeaderByRange"
+	ApiService_GetAccount_FullMethodName                = "/rpcpb.ApiService/GetAccount"
+	ApiService_GetTokenBalance_FullMethodName           = "/rpcpb.ApiService/GetTokenBalance"
+	ApiService_GetToken721Balance_FullMethodName        = "/rpcpb.ApiService/GetToken721Balance"
+	ApiService_GetToken721Metadata_FullMethodName       = "/rpcpb.ApiService/GetToken721Metadata"
+	ApiService_GetToken721Owner_FullMethodName          = "/rpcpb.ApiService/GetToken721Owner"
+	ApiService_GetGasRatio_FullMethodName               = "/rpcpb.ApiService/GetGasRatio"
+	ApiService_GetProducerVoteInfo_FullMethodName       = "/rpcpb.ApiService/GetProducerVoteInfo"
+	ApiService_GetContract_FullMethodName               = "/rpcpb.ApiService/GetContract"
+	ApiService_GetContractHistory_FullMethodName        = "/rpcpb.ApiService/GetContractHistory"
+	ApiService_GetContractVote_FullMethodName           = "/rpcpb.ApiService/GetContractVote"
+	ApiService_GetContractStorage_FullMethodName        = "/rpcpb.ApiService/GetContractStorage"
+	ApiService_GetBatchContractStorage_FullMethodName   = "/rpcpb.ApiService/GetBatchContractStorage"
+	ApiService_ListContractStorage_FullMethodName       = "/rpcpb.ApiService/ListContractStorage"
+	ApiService_GetContractStorageFields_FullMethodName  = "/rpcpb.ApiService/GetContractStorageFields"
+	ApiService_SendTransaction_FullMethodName           = "/rpcpb.ApiService/SendTransaction"
+	ApiService_ExecTransaction_FullMethodName           = "/rpcpb.ApiService/ExecTransaction"
+	ApiService_EstimateGas_FullMethodName               = "/rpcpb.ApiService/EstimateGas"
+	ApiService_TraceTransaction_FullMethodName          = "/rpcpb.ApiService/TraceTransaction"
+	ApiService_Subscribe_FullMethodName                 = "/rpcpb.ApiService/Subscribe"
+	ApiService_GetVoterBonus_FullMethodName             = "/rpcpb.ApiService/GetVoterBonus"
+	ApiService_GetCandidateBonus_FullMethodName         = "/rpcpb.ApiService/GetCandidateBonus"
+	ApiService_GetTokenInfo_FullMethodName              = "/rpcpb.ApiService/GetTokenInfo"
+	ApiService_GetBlockTxsByContract_FullMethodName     = "/rpcpb.ApiService/GetBlockTxsByContract"
+	ApiService_GetTxProof_FullMethodName                = "/rpcpb.ApiService/GetTxProof"
+	ApiService_GetReceiptProof_FullMethodName           = "/rpcpb.ApiService/GetReceiptProof"
+	ApiService_GetTxPoolStats_FullMethodName            = "/rpcpb.ApiService/GetTxPoolStats"
+	ApiService_ListPendingTransactions_FullMethodName   = "/rpcpb.ApiService/ListPendingTransactions"
+	ApiService_GetTxPoolStatus_FullMethodName           = "/rpcpb.ApiService/GetTxPoolStatus"
 )
 
 // ApiServiceClient is the client API for ApiService service.
@@ -64,6 +73,8 @@ type ApiServiceClient interface {
 	GetTxByHash(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
 	// get transaction receipt by transaction hash
 	GetTxReceiptByTxHash(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TxReceipt, error)
+	// get transaction receipt with its state diff by transaction hash, the node should record it by vm.statediff
+	GetTxReceiptWithStateDiff(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TxReceipt, error)
 	// get block by hash
 	GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*BlockResponse, error)
 	// get block by number
@@ -88,6 +99,8 @@ type ApiServiceClient interface {
 	GetProducerVoteInfo(ctx context.Context, in *GetProducerVoteInfoRequest, opts ...grpc.CallOption) (*GetProducerVoteInfoResponse, error)
 	// get contract
 	GetContract(ctx context.Context, in *GetContractRequest, opts ...grpc.CallOption) (*Contract, error)
+	// get version history of contract
+	GetContractHistory(ctx context.Context, in *GetContractRequest, opts ...grpc.CallOption) (*ContractHistory, error)
 	// get contract vote
 	GetContractVote(ctx context.Context, in *GetContractRequest, opts ...grpc.CallOption) (*ContractVote, error)
 	// get contract storage
@@ -101,12 +114,26 @@ type ApiServiceClient interface {
 	SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
 	// execute transaction
 	ExecTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*TxReceipt, error)
+	// estimate the gas usage of a transaction and recommend a gas limit
+	EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// trace the contract calls of a transaction in block or a hypothetical transaction
+	TraceTransaction(ctx context.Context, in *TraceTransactionRequest, opts ...grpc.CallOption) (*TraceTransactionResponse, error)
 	// subscribe an event
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error)
 	GetVoterBonus(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*VoterBonus, error)
 	GetCandidateBonus(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*CandidateBonus, error)
 	GetTokenInfo(ctx context.Context, in *GetTokenInfoRequest, opts ...grpc.CallOption) (*TokenInfo, error)
 	GetBlockTxsByContract(ctx context.Context, in *GetBlockTxsByContractRequest, opts ...grpc.CallOption) (*BlockTxsByContractResponse, error)
+	// get merkle proof of a transaction against the tx merkle root of its block
+	GetTxProof(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*MerkleProofResponse, error)
+	// get merkle proof of a transaction receipt against the receipt merkle root of its block
+	GetReceiptProof(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*MerkleProofResponse, error)
+	// get pending transactions of an account in the tx pool
+	GetTxPoolStats(ctx context.Context, in *GetTxPoolStatsRequest, opts ...grpc.CallOption) (*TxPoolStatsResponse, error)
+	// list pending transactions in the tx pool in order of priority
+	ListPendingTransactions(ctx context.Context, in *ListPendingTransactionsRequest, opts ...grpc.CallOption) (*ListPendingTransactionsResponse, error)
+	// get status of the tx pool
+	GetTxPoolStatus(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*TxPoolStatusResponse, error)
 }
 
 type apiServiceClient struct {
@@ -167,6 +194,16 @@ func (c *apiServiceClient) GetTxReceiptByTxHash(ctx context.Context, in *TxHashR
 	return out, nil
 }
 
+func (c *apiServiceClient) GetTxReceiptWithStateDiff(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TxReceipt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TxReceipt)
+	err := c.cc.Invoke(ctx, ApiService_GetTxReceiptWithStateDiff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BlockResponse)
@@ -287,6 +324,16 @@ func (c *apiServiceClient) GetContract(ctx context.Context, in *GetContractReque
 	return out, nil
 }
 
+func (c *apiServiceClient) GetContractHistory(ctx context.Context, in *GetContractRequest, opts ...grpc.CallOption) (*ContractHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContractHistory)
+	err := c.cc.Invoke(ctx, ApiService_GetContractHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) GetContractVote(ctx context.Context, in *GetContractRequest, opts ...grpc.CallOption) (*ContractVote, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ContractVote)
@@ -357,6 +404,26 @@ func (c *apiServiceClient) ExecTransaction(ctx context.Context, in *TransactionR
 	return out, nil
 }
 
+func (c *apiServiceClient) EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EstimateGasResponse)
+	err := c.cc.Invoke(ctx, ApiService_EstimateGas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) TraceTransaction(ctx context.Context, in *TraceTransactionRequest, opts ...grpc.CallOption) (*TraceTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TraceTransactionResponse)
+	err := c.cc.Invoke(ctx, ApiService_TraceTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &ApiService_ServiceDesc.Streams[0], ApiService_Subscribe_FullMethodName, cOpts...)
@@ -430,6 +497,56 @@ func (c *apiServiceClient) GetBlockTxsByContract(ctx context.Context, in *GetBlo
 	return out, nil
 }
 
+func (c *apiServiceClient) GetTxProof(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*MerkleProofResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MerkleProofResponse)
+	err := c.cc.Invoke(ctx, ApiService_GetTxProof_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetReceiptProof(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*MerkleProofResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MerkleProofResponse)
+	err := c.cc.Invoke(ctx, ApiService_GetReceiptProof_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTxPoolStats(ctx context.Context, in *GetTxPoolStatsRequest, opts ...grpc.CallOption) (*TxPoolStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TxPoolStatsResponse)
+	err := c.cc.Invoke(ctx, ApiService_GetTxPoolStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ListPendingTransactions(ctx context.Context, in *ListPendingTransactionsRequest, opts ...grpc.CallOption) (*ListPendingTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingTransactionsResponse)
+	err := c.cc.Invoke(ctx, ApiService_ListPendingTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTxPoolStatus(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*TxPoolStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TxPoolStatusResponse)
+	err := c.cc.Invoke(ctx, ApiService_GetTxPoolStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApiServiceServer is the server API for ApiService service.
 // All implementations should embed UnimplementedApiServiceServer
 // for forward compatibility
@@ -444,6 +561,8 @@ type ApiServiceServer interface {
 	GetTxByHash(context.Context, *TxHashRequest) (*TransactionResponse, error)
 	// get transaction receipt by transaction hash
 	GetTxReceiptByTxHash(context.Context, *TxHashRequest) (*TxReceipt, error)
+	// get transaction receipt with its state diff by transaction hash, the node should record it by vm.statediff
+	GetTxReceiptWithStateDiff(context.Context, *TxHashRequest) (*TxReceipt, error)
 	// get block by hash
 	GetBlockByHash(context.Context, *GetBlockByHashRequest) (*BlockResponse, error)
 	// get block by number
@@ -468,6 +587,8 @@ type ApiServiceServer interface {
 	GetProducerVoteInfo(context.Context, *GetProducerVoteInfoRequest) (*GetProducerVoteInfoResponse, error)
 	// get contract
 	GetContract(context.Context, *GetContractRequest) (*Contract, error)
+	// get version history of contract
+	GetContractHistory(context.Context, *GetContractRequest) (*ContractHistory, error)
 	// get contract vote
 	GetContractVote(context.Context, *GetContractRequest) (*ContractVote, error)
 	// get contract storage
@@ -481,12 +602,26 @@ type ApiServiceServer interface {
 	SendTransaction(context.Context, *TransactionRequest) (*SendTransactionResponse, error)
 	// execute transaction
 	ExecTransaction(context.Context, *TransactionRequest) (*TxReceipt, error)
+	// estimate the gas usage of a transaction and recommend a gas limit
+	EstimateGas(context.Context, *TransactionRequest) (*EstimateGasResponse, error)
+	// trace the contract calls of a transaction in block or a hypothetical transaction
+	TraceTransaction(context.Context, *TraceTransactionRequest) (*TraceTransactionResponse, error)
 	// subscribe an event
 	Subscribe(*SubscribeRequest, ApiService_SubscribeServer) error
 	GetVoterBonus(context.Context, *GetAccountRequest) (*VoterBonus, error)
 	GetCandidateBonus(context.Context, *GetAccountRequest) (*CandidateBonus, error)
 	GetTokenInfo(context.Context, *GetTokenInfoRequest) (*TokenInfo, error)
 	GetBlockTxsByContract(context.Context, *GetBlockTxsByContractRequest) (*BlockTxsByContractResponse, error)
+	// get merkle proof of a transaction against the tx merkle root of its block
+	GetTxProof(context.Context, *TxHashRequest) (*MerkleProofResponse, error)
+	// get merkle proof of a transaction receipt against the receipt merkle root of its block
+	GetReceiptProof(context.Context, *TxHashRequest) (*MerkleProofResponse, error)
+	// get pending transactions of an account in the tx pool
+	GetTxPoolStats(context.Context, *GetTxPoolStatsRequest) (*TxPoolStatsResponse, error)
+	// list pending transactions in the tx pool in order of priority
+	ListPendingTransactions(context.Context, *ListPendingTransactionsRequest) (*ListPendingTransactionsResponse, error)
+	// get status of the tx pool
+	GetTxPoolStatus(context.Context, *EmptyRequest) (*TxPoolStatusResponse, error)
 }
 
 // UnimplementedApiServiceServer should be embedded to have forward compatible implementations.
@@ -508,6 +643,9 @@ func (UnimplementedApiServiceServer) GetTxByHash(context.Context, *TxHashRequest
 func (UnimplementedApiServiceServer) GetTxReceiptByTxHash(context.Context, *TxHashRequest) (*TxReceipt, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTxReceiptByTxHash not implemented")
 }
+func (UnimplementedApiServiceServer) GetTxReceiptWithStateDiff(context.Context, *TxHashRequest) (*TxReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxReceiptWithStateDiff not implemented")
+}
 func (UnimplementedApiServiceServer) GetBlockByHash(context.Context, *GetBlockByHashRequest) (*BlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByHash not implemented")
 }
@@ -544,6 +682,9 @@ func (UnimplementedApiServiceServer) GetProducerVoteInfo(context.Context, *GetPr
 func (UnimplementedApiServiceServer) GetContract(context.Context, *GetContractRequest) (*Contract, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetContract not implemented")
 }
+func (UnimplementedApiServiceServer) GetContractHistory(context.Context, *GetContractRequest) (*ContractHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContractHistory not implemented")
+}
 func (UnimplementedApiServiceServer) GetContractVote(context.Context, *GetContractRequest) (*ContractVote, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetContractVote not implemented")
 }
@@ -565,6 +706,12 @@ func (UnimplementedApiServiceServer) SendTransaction(context.Context, *Transacti
 func (UnimplementedApiServiceServer) ExecTransaction(context.Context, *TransactionRequest) (*TxReceipt, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecTransaction not implemented")
 }
+func (UnimplementedApiServiceServer) EstimateGas(context.Context, *TransactionRequest) (*EstimateGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
+}
+func (UnimplementedApiServiceServer) TraceTransaction(context.Context, *TraceTransactionRequest) (*TraceTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceTransaction not implemented")
+}
 func (UnimplementedApiServiceServer) Subscribe(*SubscribeRequest, ApiService_SubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
@@ -580,6 +727,21 @@ func (UnimplementedApiServiceServer) GetTokenInfo(context.Context, *GetTokenInfo
 func (UnimplementedApiServiceServer) GetBlockTxsByContract(context.Context, *GetBlockTxsByContractRequest) (*BlockTxsByContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockTxsByContract not implemented")
 }
+func (UnimplementedApiServiceServer) GetTxProof(context.Context, *TxHashRequest) (*MerkleProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxProof not implemented")
+}
+func (UnimplementedApiServiceServer) GetReceiptProof(context.Context, *TxHashRequest) (*MerkleProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceiptProof not implemented")
+}
+func (UnimplementedApiServiceServer) GetTxPoolStats(context.Context, *GetTxPoolStatsRequest) (*TxPoolStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxPoolStats not implemented")
+}
+func (UnimplementedApiServiceServer) ListPendingTransactions(context.Context, *ListPendingTransactionsRequest) (*ListPendingTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingTransactions not implemented")
+}
+func (UnimplementedApiServiceServer) GetTxPoolStatus(context.Context, *EmptyRequest) (*TxPoolStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxPoolStatus not implemented")
+}
 
 // UnsafeApiServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ApiServiceServer will
@@ -682,6 +844,24 @@ func _ApiService_GetTxReceiptByTxHash_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetTxReceiptWithStateDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxReceiptWithStateDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiService_GetTxReceiptWithStateDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxReceiptWithStateDiff(ctx, req.(*TxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_GetBlockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBlockByHashRequest)
 	if err := dec(in); err != nil {
@@ -898,6 +1078,24 @@ func _ApiService_GetContract_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetContractHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetContractHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiService_GetContractHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetContractHistory(ctx, req.(*GetContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_GetContractVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetContractRequest)
 	if err := dec(in); err != nil {
@@ -1024,6 +1222,42 @@ func _ApiService_ExecTransaction_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).EstimateGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiService_EstimateGas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).EstimateGas(ctx, req.(*TransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_TraceTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraceTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).TraceTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiService_TraceTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).TraceTransaction(ctx, req.(*TraceTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscribeRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1117,6 +1351,96 @@ func _ApiService_GetBlockTxsByContract_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetTxProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiService_GetTxProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxProof(ctx, req.(*TxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetReceiptProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetReceiptProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiService_GetReceiptProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetReceiptProof(ctx, req.(*TxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetTxPoolStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxPoolStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxPoolStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiService_GetTxPoolStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxPoolStats(ctx, req.(*GetTxPoolStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_ListPendingTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).ListPendingTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiService_ListPendingTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).ListPendingTransactions(ctx, req.(*ListPendingTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetTxPoolStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxPoolStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiService_GetTxPoolStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxPoolStatus(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ApiService_ServiceDesc is the grpc.ServiceDesc for ApiService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1144,6 +1468,10 @@ var ApiService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTxReceiptByTxHash",
 			Handler:    _ApiService_GetTxReceiptByTxHash_Handler,
 		},
+		{
+			MethodName: "GetTxReceiptWithStateDiff",
+			Handler:    _ApiService_GetTxReceiptWithStateDiff_Handler,
+		},
 		{
 			MethodName: "GetBlockByHash",
 			Handler:    _ApiService_GetBlockByHash_Handler,
@@ -1192,6 +1520,10 @@ var ApiService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetContract",
 			Handler:    _ApiService_GetContract_Handler,
 		},
+		{
+			MethodName: "GetContractHistory",
+			Handler:    _ApiService_GetContractHistory_Handler,
+		},
 		{
 			MethodName: "GetContractVote",
 			Handler:    _ApiService_GetContractVote_Handler,
@@ -1220,6 +1552,14 @@ var ApiService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExecTransaction",
 			Handler:    _ApiService_ExecTransaction_Handler,
 		},
+		{
+			MethodName: "EstimateGas",
+			Handler:    _ApiService_EstimateGas_Handler,
+		},
+		{
+			MethodName: "TraceTransaction",
+			Handler:    _ApiService_TraceTransaction_Handler,
+		},
 		{
 			MethodName: "GetVoterBonus",
 			Handler:    _ApiService_GetVoterBonus_Handler,
@@ -1236,6 +1576,26 @@ var ApiService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockTxsByContract",
 			Handler:    _ApiService_GetBlockTxsByContract_Handler,
 		},
+		{
+			MethodName: "GetTxProof",
+			Handler:    _ApiService_GetTxProof_Handler,
+		},
+		{
+			MethodName: "GetReceiptProof",
+			Handler:    _ApiService_GetReceiptProof_Handler,
+		},
+		{
+			MethodName: "GetTxPoolStats",
+			Handler:    _ApiService_GetTxPoolStats_Handler,
+		},
+		{
+			MethodName: "ListPendingTransactions",
+			Handler:    _ApiService_ListPendingTransactions_Handler,
+		},
+		{
+			MethodName: "GetTxPoolStatus",
+			Handler:    _ApiService_GetTxPoolStatus_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,220 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	secp "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/iost-official/go-iost/v3/account"
+	"github.com/iost-official/go-iost/v3/common"
+	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// A multi-signature tx is saved as the json file of TransactionRequest without publisher signature,
+// the signatures of signers are collected in its `signatures` field by signing or combining files.
+
+// SignerStatus is how much a signer permission of tx is satisfied by the collected signatures.
+type SignerStatus struct {
+	Signer    string
+	Weight    int64
+	Threshold int64
+	Satisfied bool
+}
+
+// AddSignerSignature signs the tx as a signer and adds the signature, signing again with the same key does nothing.
+func AddSignerSignature(t *rpcpb.TransactionRequest, kp *account.KeyPair) *rpcpb.Signature {
+	sig := GetSignatureOfTx(t, kp, false)
+	for _, s := range t.Signatures {
+		if bytes.Equal(s.PublicKey, sig.PublicKey) {
+			return s
+		}
+	}
+	t.Signatures = append(t.Signatures, sig)
+	return sig
+}
+
+// VerifySignerSignature checks whether the signature is signed by its public key on the tx as a signer.
+func VerifySignerSignature(t *rpcpb.TransactionRequest, sig *rpcpb.Signature) bool {
+	return verifySignature(t, sig, false)
+}
+
+func verifySignature(t *rpcpb.TransactionRequest, sig *rpcpb.Signature, withSign bool) bool {
+	hash := common.Sha3(txToBytes(t, withSign))
+	return GetSignAlgoByEnum(sig.Algorithm).Verify(hash, sig.PublicKey, sig.Signature)
+}
+
+// CombineSignedTxs merges the signer signatures of the same tx, signatures with duplicate keys are kept once.
+func CombineSignedTxs(txs ...*rpcpb.TransactionRequest) (*rpcpb.TransactionRequest, error) {
+	if len(txs) == 0 {
+		return nil, fmt.Errorf("no transaction to combine")
+	}
+	ret := proto.Clone(txs[0]).(*rpcpb.TransactionRequest)
+	ret.Signatures = []*rpcpb.Signature{}
+	hash := txToBytes(ret, false)
+	keys := make(map[string]bool)
+	for i, t := range txs {
+		if !bytes.Equal(hash, txToBytes(t, false)) {
+			return nil, fmt.Errorf("transaction %v is different from the first one", i)
+		}
+		for _, sig := range t.Signatures {
+			k := account.EncodePubkey(sig.PublicKey)
+			if keys[k] {
+				continue
+			}
+			if !VerifySignerSignature(ret, sig) {
+				return nil, fmt.Errorf("invalid signature of %v in transaction %v", k, i)
+			}
+			keys[k] = true
+			ret.Signatures = append(ret.Signatures, sig)
+		}
+	}
+	return ret, nil
+}
+
+// SignerStatus returns how much each signer permission of the tx is satisfied, by the permissions of accounts on chain.
+// It follows the check of chain: keys of both signer and publisher signatures count, items of groups count,
+// an unsatisfied permission falls back to active and owner, and an eth address account is satisfied by the key of its address.
+func (s *IOSTDevSDK) SignerStatus(t *rpcpb.TransactionRequest) ([]*SignerStatus, error) {
+	c := &authChecker{
+		s:         s,
+		keys:      make(map[string]bool),
+		addresses: make(map[string]bool),
+		accounts:  make(map[string]*rpcpb.Account),
+	}
+	for _, sig := range t.Signatures {
+		if verifySignature(t, sig, false) {
+			c.addKey(sig.PublicKey)
+		}
+	}
+	for _, sig := range t.PublisherSigs {
+		if verifySignature(t, sig, true) {
+			c.addKey(sig.PublicKey)
+		}
+	}
+	ret := make([]*SignerStatus, 0, len(t.Signers))
+	for _, signer := range t.Signers {
+		ss := strings.Split(signer, "@")
+		if len(ss) != 2 {
+			return nil, fmt.Errorf("signer %v should contain '@'", signer)
+		}
+		st := &SignerStatus{Signer: signer}
+		var err error
+		st.Weight, st.Threshold, st.Satisfied, err = c.check(ss[0], ss[1], make(map[string]bool))
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, st)
+	}
+	return ret, nil
+}
+
+type authChecker struct {
+	s         *IOSTDevSDK
+	keys      map[string]bool
+	addresses map[string]bool
+	// nil for the account not found
+	accounts map[string]*rpcpb.Account
+}
+
+func (c *authChecker) addKey(pubkey []byte) {
+	c.keys[account.EncodePubkey(pubkey)] = true
+	if addr := ethAddress(pubkey); addr != "" {
+		c.addresses[addr] = true
+	}
+}
+
+// ethAddress returns the eth address of the secp256k1 public key, or "" for other keys.
+func ethAddress(pubkey []byte) string {
+	pk, err := secp.ParsePubKey(pubkey)
+	if err != nil {
+		return ""
+	}
+	hash := common.Sha3(pk.SerializeUncompressed()[1:])
+	return "0x" + hex.EncodeToString(hash[12:32])
+}
+
+func isEthAddress(id string) bool {
+	return strings.HasPrefix(id, "0x") && len(id) == 42
+}
+
+func (c *authChecker) account(id string) (*rpcpb.Account, error) {
+	if a, ok := c.accounts[id]; ok {
+		return a, nil
+	}
+	a, err := c.s.GetAccountInfo(id)
+	if err != nil {
+		if status.Convert(err).Message() != "account not found" {
+			return nil, fmt.Errorf("failed to get account %v: %v", id, err)
+		}
+		a = nil
+	}
+	c.accounts[id] = a
+	return a, nil
+}
+
+// check returns the weight and threshold of the permission itself, and whether it is satisfied after falling back.
+func (c *authChecker) check(id, perm string, reenter map[string]bool) (int64, int64, bool, error) {
+	if reenter[id+"@"+perm] {
+		return 0, 0, false, nil
+	}
+	reenter[id+"@"+perm] = true
+
+	a, err := c.account(id)
+	if err != nil || a == nil {
+		return 0, 0, false, err
+	}
+	if isEthAddress(id) {
+		if c.addresses[id] {
+			return 1, 1, true, nil
+		}
+		return 0, 1, false, nil
+	}
+	p, ok := a.Permissions[perm]
+	if !ok {
+		if perm == "owner" || perm == "active" {
+			return 0, 0, false, nil
+		}
+		_, _, ok, err := c.check(id, "active", reenter)
+		return 0, 0, ok, err
+	}
+
+	items := append([]*rpcpb.Account_Item{}, p.Items...)
+	for _, g := range p.GroupNames {
+		if grp, ok := a.Groups[g]; ok {
+			items = append(items, grp.Items...)
+		}
+	}
+	var weight int64
+	for _, item := range items {
+		if item.IsKeyPair {
+			if c.keys[item.Id] {
+				weight += item.Weight
+			}
+			continue
+		}
+		_, _, ok, err := c.check(item.Id, item.Permission, reenter)
+		if err != nil {
+			return 0, 0, false, err
+		}
+		if ok {
+			weight += item.Weight
+		}
+	}
+	if weight >= p.Threshold {
+		return weight, p.Threshold, true, nil
+	}
+
+	switch perm {
+	case "owner":
+		return weight, p.Threshold, false, nil
+	case "active":
+		_, _, ok, err = c.check(id, "owner", reenter)
+	default:
+		_, _, ok, err = c.check(id, "active", reenter)
+	}
+	return weight, p.Threshold, ok, err
+}
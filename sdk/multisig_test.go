@@ -0,0 +1,317 @@
+package sdk
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/iost-official/go-iost/v3/account"
+	"github.com/iost-official/go-iost/v3/crypto"
+	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func newKeyPair(t *testing.T, algo crypto.Algorithm) *account.KeyPair {
+	kp, err := account.NewKeyPair(nil, algo)
+	assert.NoError(t, err)
+	return kp
+}
+
+func keyItem(kp *account.KeyPair, weight int64) *rpcpb.Account_Item {
+	return &rpcpb.Account_Item{Id: kp.ReadablePubkey(), IsKeyPair: true, Weight: weight}
+}
+
+func accountItem(id, perm string, weight int64) *rpcpb.Account_Item {
+	return &rpcpb.Account_Item{Id: id, Permission: perm, Weight: weight}
+}
+
+func permission(threshold int64, items ...*rpcpb.Account_Item) *rpcpb.Account_Permission {
+	return &rpcpb.Account_Permission{Items: items, Threshold: threshold}
+}
+
+func newMultisigTx(t *testing.T, signers ...string) *rpcpb.TransactionRequest {
+	s := NewIOSTDevSDK()
+	trx, err := s.CreateTxFromActions([]*rpcpb.Action{NewAction("token.iost", "transfer", `["iost","user_1","user_2","10",""]`)})
+	assert.NoError(t, err)
+	trx.Signers = signers
+	return trx
+}
+
+func TestMultisigCreate(t *testing.T) {
+	kp := newKeyPair(t, crypto.Ed25519)
+	tests := []struct {
+		name    string
+		signers []string
+		signed  bool
+	}{
+		{"unsigned", []string{"user_1@active"}, false},
+		{"signed", []string{"user_1@active", "user_2@owner"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trx := newMultisigTx(t, tt.signers...)
+			if tt.signed {
+				AddSignerSignature(trx, kp)
+			}
+			f := filepath.Join(t.TempDir(), "tx.json")
+			assert.NoError(t, SaveProtoStructToJSONFile(trx, f))
+			loaded := &rpcpb.TransactionRequest{}
+			assert.NoError(t, LoadProtoStructFromJSONFile(f, loaded))
+			assert.Equal(t, txToBytes(trx, true), txToBytes(loaded, true))
+			assert.Equal(t, tt.signers, loaded.Signers)
+			assert.Empty(t, loaded.PublisherSigs)
+			for _, sig := range loaded.Signatures {
+				assert.True(t, VerifySignerSignature(loaded, sig))
+			}
+		})
+	}
+}
+
+func TestMultisigSign(t *testing.T) {
+	k1 := newKeyPair(t, crypto.Ed25519)
+	k2 := newKeyPair(t, crypto.Secp256k1)
+	tests := []struct {
+		name  string
+		keys  []*account.KeyPair
+		count int
+	}{
+		{"one key", []*account.KeyPair{k1}, 1},
+		{"two keys", []*account.KeyPair{k1, k2}, 2},
+		{"same key twice", []*account.KeyPair{k1, k1}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trx := newMultisigTx(t, "user_1@active")
+			for _, kp := range tt.keys {
+				sig := AddSignerSignature(trx, kp)
+				assert.Equal(t, kp.Pubkey, sig.PublicKey)
+			}
+			assert.Len(t, trx.Signatures, tt.count)
+			for _, sig := range trx.Signatures {
+				assert.True(t, VerifySignerSignature(trx, sig))
+			}
+			trx.Expiration++
+			for _, sig := range trx.Signatures {
+				assert.False(t, VerifySignerSignature(trx, sig), "the signature is of the tx content")
+			}
+		})
+	}
+}
+
+func TestMultisigCombine(t *testing.T) {
+	k1 := newKeyPair(t, crypto.Ed25519)
+	k2 := newKeyPair(t, crypto.Secp256k1)
+	base := newMultisigTx(t, "user_1@active", "user_2@active")
+	signed := func(modify func(*rpcpb.TransactionRequest), keys ...*account.KeyPair) *rpcpb.TransactionRequest {
+		trx := proto.Clone(base).(*rpcpb.TransactionRequest)
+		if modify != nil {
+			modify(trx)
+		}
+		for _, kp := range keys {
+			AddSignerSignature(trx, kp)
+		}
+		return trx
+	}
+	tests := []struct {
+		name  string
+		txs   []*rpcpb.TransactionRequest
+		count int
+		err   bool
+	}{
+		{"no tx", nil, 0, true},
+		{"merged", []*rpcpb.TransactionRequest{signed(nil, k1), signed(nil, k2)}, 2, false},
+		{"duplicate keys", []*rpcpb.TransactionRequest{signed(nil, k1, k2), signed(nil, k2)}, 2, false},
+		{"unsigned", []*rpcpb.TransactionRequest{signed(nil), signed(nil, k1)}, 1, false},
+		{"different tx", []*rpcpb.TransactionRequest{signed(nil, k1), signed(func(trx *rpcpb.TransactionRequest) { trx.GasLimit++ }, k2)}, 0, true},
+		{"invalid signature", []*rpcpb.TransactionRequest{signed(nil, k1), signed(nil, k2)}, 0, true},
+	}
+	tests[len(tests)-1].txs[1].Signatures[0].Signature[0] ^= 0xff
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trx, err := CombineSignedTxs(tt.txs...)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, trx.Signatures, tt.count)
+			assert.Equal(t, txToBytes(base, false), txToBytes(trx, false))
+		})
+	}
+}
+
+func TestSignerStatus(t *testing.T) {
+	k1 := newKeyPair(t, crypto.Ed25519)
+	k2 := newKeyPair(t, crypto.Ed25519)
+	k3 := newKeyPair(t, crypto.Secp256k1)
+	addr := ethAddress(k3.Pubkey)
+	assert.Len(t, addr, 42)
+
+	tests := []struct {
+		name      string
+		signer    string
+		accounts  []*rpcpb.Account
+		keys      []*account.KeyPair
+		publisher *account.KeyPair
+		weight    int64
+		threshold int64
+		satisfied bool
+	}{
+		{
+			name:   "signed",
+			signer: "user_1@active",
+			accounts: []*rpcpb.Account{{Name: "user_1", Permissions: map[string]*rpcpb.Account_Permission{
+				"active": permission(1, keyItem(k1, 1)),
+			}}},
+			keys:   []*account.KeyPair{k1},
+			weight: 1, threshold: 1, satisfied: true,
+		},
+		{
+			name:   "not signed",
+			signer: "user_1@active",
+			accounts: []*rpcpb.Account{{Name: "user_1", Permissions: map[string]*rpcpb.Account_Permission{
+				"active": permission(1, keyItem(k1, 1)),
+			}}},
+			keys:   []*account.KeyPair{k2},
+			weight: 0, threshold: 1, satisfied: false,
+		},
+		{
+			name:   "below threshold",
+			signer: "user_1@active",
+			accounts: []*rpcpb.Account{{Name: "user_1", Permissions: map[string]*rpcpb.Account_Permission{
+				"active": permission(3, keyItem(k1, 1), keyItem(k2, 2)),
+			}}},
+			keys:   []*account.KeyPair{k1},
+			weight: 1, threshold: 3, satisfied: false,
+		},
+		{
+			name:   "weights add up",
+			signer: "user_1@active",
+			accounts: []*rpcpb.Account{{Name: "user_1", Permissions: map[string]*rpcpb.Account_Permission{
+				"active": permission(3, keyItem(k1, 1), keyItem(k2, 2)),
+			}}},
+			keys:   []*account.KeyPair{k1, k2},
+			weight: 3, threshold: 3, satisfied: true,
+		},
+		{
+			name:   "publisher signature counts",
+			signer: "user_1@active",
+			accounts: []*rpcpb.Account{{Name: "user_1", Permissions: map[string]*rpcpb.Account_Permission{
+				"active": permission(3, keyItem(k1, 1), keyItem(k2, 2)),
+			}}},
+			keys:      []*account.KeyPair{k1},
+			publisher: k2,
+			weight:    3, threshold: 3, satisfied: true,
+		},
+		{
+			name:   "group items count",
+			signer: "user_1@perm",
+			accounts: []*rpcpb.Account{{
+				Name: "user_1",
+				Permissions: map[string]*rpcpb.Account_Permission{
+					"perm": {GroupNames: []string{"grp"}, Threshold: 1},
+				},
+				Groups: map[string]*rpcpb.Account_Group{"grp": {Name: "grp", Items: []*rpcpb.Account_Item{keyItem(k1, 1)}}},
+			}},
+			keys:   []*account.KeyPair{k1},
+			weight: 1, threshold: 1, satisfied: true,
+		},
+		{
+			name:   "active falls back to owner",
+			signer: "user_1@active",
+			accounts: []*rpcpb.Account{{Name: "user_1", Permissions: map[string]*rpcpb.Account_Permission{
+				"active": permission(1, keyItem(k1, 1)),
+				"owner":  permission(1, keyItem(k2, 1)),
+			}}},
+			keys:   []*account.KeyPair{k2},
+			weight: 0, threshold: 1, satisfied: true,
+		},
+		{
+			name:   "missing permission falls back to active",
+			signer: "user_1@perm",
+			accounts: []*rpcpb.Account{{Name: "user_1", Permissions: map[string]*rpcpb.Account_Permission{
+				"active": permission(1, keyItem(k1, 1)),
+			}}},
+			keys:   []*account.KeyPair{k1},
+			weight: 0, threshold: 0, satisfied: true,
+		},
+		{
+			name:   "account item",
+			signer: "user_1@active",
+			accounts: []*rpcpb.Account{
+				{Name: "user_1", Permissions: map[string]*rpcpb.Account_Permission{
+					"active": permission(2, keyItem(k1, 1), accountItem("user_2", "active", 1)),
+				}},
+				{Name: "user_2", Permissions: map[string]*rpcpb.Account_Permission{
+					"active": permission(1, keyItem(k2, 1)),
+				}},
+			},
+			keys:   []*account.KeyPair{k1, k2},
+			weight: 2, threshold: 2, satisfied: true,
+		},
+		{
+			name:   "account item not found",
+			signer: "user_1@active",
+			accounts: []*rpcpb.Account{{Name: "user_1", Permissions: map[string]*rpcpb.Account_Permission{
+				"active": permission(2, keyItem(k1, 1), accountItem("user_2", "active", 1)),
+			}}},
+			keys:   []*account.KeyPair{k1, k2},
+			weight: 1, threshold: 2, satisfied: false,
+		},
+		{
+			name:     "eth address",
+			signer:   addr + "@active",
+			accounts: []*rpcpb.Account{{Name: addr}},
+			keys:     []*account.KeyPair{k3},
+			weight:   1, threshold: 1, satisfied: true,
+		},
+		{
+			name:      "eth address by publisher",
+			signer:    addr + "@active",
+			accounts:  []*rpcpb.Account{{Name: addr}},
+			publisher: k3,
+			weight:    1, threshold: 1, satisfied: true,
+		},
+		{
+			name:     "eth address of other key",
+			signer:   addr + "@active",
+			accounts: []*rpcpb.Account{{Name: addr}},
+			keys:     []*account.KeyPair{k1},
+			weight:   0, threshold: 1, satisfied: false,
+		},
+		{
+			name:   "account not found",
+			signer: addr + "@active",
+			keys:   []*account.KeyPair{k3},
+			weight: 0, threshold: 0, satisfied: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sdk, server := newTestSDK(t)
+			for _, a := range tt.accounts {
+				server.accounts[a.Name] = a
+			}
+			trx := newMultisigTx(t, tt.signer)
+			for _, kp := range tt.keys {
+				AddSignerSignature(trx, kp)
+			}
+			if tt.publisher != nil {
+				trx.PublisherSigs = []*rpcpb.Signature{GetSignatureOfTx(trx, tt.publisher, true)}
+			}
+			status, err := sdk.SignerStatus(trx)
+			assert.NoError(t, err)
+			assert.Len(t, status, 1)
+			assert.Equal(t, tt.signer, status[0].Signer)
+			assert.Equal(t, tt.weight, status[0].Weight)
+			assert.Equal(t, tt.threshold, status[0].Threshold)
+			assert.Equal(t, tt.satisfied, status[0].Satisfied)
+		})
+	}
+
+	t.Run("invalid signer", func(t *testing.T) {
+		sdk, _ := newTestSDK(t)
+		_, err := sdk.SignerStatus(newMultisigTx(t, "user_1"))
+		assert.Error(t, err)
+	})
+}
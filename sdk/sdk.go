@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/iost-official/go-iost/v3/account"
@@ -17,6 +18,9 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// DefaultGasLimit is the gas limit used if it isn't set and the node can't estimate it.
+const DefaultGasLimit = 1000000
+
 // IOSTDevSDK ...
 type IOSTDevSDK struct {
 	// the remote server to connect to
@@ -28,12 +32,16 @@ type IOSTDevSDK struct {
 	accountName string
 	permission  string
 
-	// fields used to fill tx
+	// fields used to fill tx, the gas limit is estimated by the node if it is 0
 	gasLimit    float64
 	gasRatio    float64
 	expiration  int64
 	amountLimit []*rpcpb.AmountLimit
 	delaySecond int64
+	// infer the amount limit by a dry run instead of using amountLimit
+	inferAmountLimit bool
+	// asks whether to use the inferred amount limit, the tx isn't created if it returns false
+	confirmAmountLimit func(limit []*rpcpb.AmountLimit) bool
 
 	// whether to check tx after sending by `SendTx`
 	checkResult         bool
@@ -61,7 +69,7 @@ func NewIOSTDevSDK() *IOSTDevSDK {
 		checkResultDelay:    3,
 		checkResultMaxRetry: 20,
 		accounts:            make(map[string]map[string]*account.KeyPair),
-		gasLimit:            1000000,
+		gasLimit:            DefaultGasLimit,
 		gasRatio:            1.0,
 		amountLimit:         []*rpcpb.AmountLimit{{Token: "*", Value: "unlimited"}},
 		expiration:          90,
@@ -100,6 +108,13 @@ func (s *IOSTDevSDK) SetAccount(name string, kp *account.KeyPair) {
 	s.UseAccountAndPerm(name, "active")
 }
 
+// SetInferAmountLimit sets whether to infer the amount limit of the created tx by a dry run on the node.
+// The inferred amount limit is used only if confirm returns true, it is used without asking if confirm is nil.
+func (s *IOSTDevSDK) SetInferAmountLimit(infer bool, confirm func(limit []*rpcpb.AmountLimit) bool) {
+	s.inferAmountLimit = infer
+	s.confirmAmountLimit = confirm
+}
+
 // SetTxInfo ...
 func (s *IOSTDevSDK) SetTxInfo(gasLimit float64, gasRatio float64, expiration int64, delaySecond int64, amountLimit []*rpcpb.AmountLimit) {
 	s.gasLimit = gasLimit
@@ -374,6 +389,22 @@ func (s *IOSTDevSDK) ExecTransaction(signedTx *rpcpb.TransactionRequest) (*rpcpb
 	return resp, nil
 }
 
+// EstimateGas estimates the gas usage of the transaction by the node
+func (s *IOSTDevSDK) EstimateGas(signedTx *rpcpb.TransactionRequest) (*rpcpb.EstimateGasResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	resp, err := client.EstimateGas(context.Background(), signedTx)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 ////////////////////////////////////// transaction related /////////////////////////////////
 
 // CreateTxFromActions ...
@@ -394,9 +425,39 @@ func (s *IOSTDevSDK) CreateTxFromActions(actions []*rpcpb.Action) (*rpcpb.Transa
 		AmountLimit:   s.amountLimit,
 		Signatures:    []*rpcpb.Signature{},
 	}
+	if s.inferAmountLimit {
+		limit, err := s.EstimateAmountLimit(ret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to infer amount limit: %v", err)
+		}
+		s.log("Inferred amount limit:", limit)
+		if s.confirmAmountLimit != nil && !s.confirmAmountLimit(limit) {
+			return nil, fmt.Errorf("the inferred amount limit is rejected")
+		}
+		ret.AmountLimit = limit
+	}
 	return ret, nil
 }
 
+// EstimateAmountLimit returns the tightest amount limit of the transaction, which is the tokens spent in a dry run by the node.
+func (s *IOSTDevSDK) EstimateAmountLimit(t *rpcpb.TransactionRequest) ([]*rpcpb.AmountLimit, error) {
+	dryRun := proto.Clone(t).(*rpcpb.TransactionRequest)
+	dryRun.AmountLimit = []*rpcpb.AmountLimit{{Token: "*", Value: "unlimited"}}
+	dryRun.PublisherSigs = []*rpcpb.Signature{}
+	signedTx, err := s.SignTx(dryRun)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.EstimateGas(signedTx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Receipt.StatusCode != rpcpb.TxReceipt_SUCCESS {
+		return nil, fmt.Errorf("dry run failed: %v", resp.Receipt.Message)
+	}
+	return resp.AmountLimit, nil
+}
+
 // SignTx ...
 func (s *IOSTDevSDK) SignTx(t *rpcpb.TransactionRequest) (*rpcpb.TransactionRequest, error) {
 	t.Publisher = s.accountName
@@ -414,6 +475,31 @@ func (s *IOSTDevSDK) SignTx(t *rpcpb.TransactionRequest) (*rpcpb.TransactionRequ
 	return t, nil
 }
 
+// fillGasLimit sets the gas limit estimated by the node if it isn't set.
+// The tx signed before is left unchanged, as the signatures include the gas limit.
+func (s *IOSTDevSDK) fillGasLimit(t *rpcpb.TransactionRequest) {
+	if t.GasLimit != 0 || len(t.PublisherSigs) != 0 || len(t.Signatures) != 0 {
+		return
+	}
+	t.GasLimit = DefaultGasLimit
+	signedTx, err := s.SignTx(proto.Clone(t).(*rpcpb.TransactionRequest))
+	if err != nil {
+		s.log("Estimate gas failed, use the default gas limit:", err)
+		return
+	}
+	resp, err := s.EstimateGas(signedTx)
+	if err != nil {
+		s.log("Estimate gas failed, use the default gas limit:", err)
+		return
+	}
+	if resp.Receipt.StatusCode != rpcpb.TxReceipt_SUCCESS {
+		s.log("Estimate gas failed, use the default gas limit:", resp.Receipt.Message)
+		return
+	}
+	t.GasLimit = resp.GasLimit
+	s.log("Estimated gas usage:", resp.GasUsage, "gas limit:", resp.GasLimit)
+}
+
 func (s *IOSTDevSDK) checkTransaction(txHash string) error {
 	s.log("Checking transaction receipt...")
 	receiptPrinted := false
@@ -466,6 +552,7 @@ func (s *IOSTDevSDK) checkTransaction(txHash string) error {
 }
 
 func (s *IOSTDevSDK) TryTx(tx *rpcpb.TransactionRequest) (*rpcpb.TxReceipt, error) {
+	s.fillGasLimit(tx)
 	signedTx, err := s.SignTx(tx)
 	if err != nil {
 		return nil, fmt.Errorf("sign tx error %v", err)
@@ -481,6 +568,7 @@ func (s *IOSTDevSDK) TryTx(tx *rpcpb.TransactionRequest) (*rpcpb.TxReceipt, erro
 
 // SendTx send transaction and check result if sdk.checkResult is set
 func (s *IOSTDevSDK) SendTx(tx *rpcpb.TransactionRequest) (string, error) {
+	s.fillGasLimit(tx)
 	signedTx, err := s.SignTx(tx)
 	if err != nil {
 		return "", fmt.Errorf("sign tx error %v", err)
@@ -601,6 +689,11 @@ func (s *IOSTDevSDK) PublishContractActions(codePath string, abiPath string, con
 		return nil, fmt.Errorf("failed to read source code file: %v", err)
 	}
 	code := string(fd)
+	isWasm := strings.HasSuffix(codePath, ".wasm")
+	if isWasm {
+		// the binary code is saved in base64
+		code = base64.StdEncoding.EncodeToString(fd)
+	}
 
 	fd, err = os.ReadFile(abiPath)
 	if err != nil {
@@ -613,6 +706,9 @@ func (s *IOSTDevSDK) PublishContractActions(codePath string, abiPath string, con
 	if err != nil {
 		return nil, err
 	}
+	if isWasm && info.Lang != "wasm" {
+		return nil, fmt.Errorf("the lang of wasm code should be wasm in abi, got %v", info.Lang)
+	}
 	c := &contract.Contract{
 		ID:   conID,
 		Code: code,
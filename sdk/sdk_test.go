@@ -0,0 +1,149 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/iost-official/go-iost/v3/account"
+	"github.com/iost-official/go-iost/v3/crypto"
+	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeServer serves the estimation by the func set in test and the accounts set in test, other methods are unimplemented.
+type fakeServer struct {
+	rpcpb.UnimplementedApiServiceServer
+	estimateGas func(*rpcpb.TransactionRequest) (*rpcpb.EstimateGasResponse, error)
+	accounts    map[string]*rpcpb.Account
+}
+
+func (f *fakeServer) GetAccount(ctx context.Context, req *rpcpb.GetAccountRequest) (*rpcpb.Account, error) {
+	a, ok := f.accounts[req.Name]
+	if !ok {
+		return nil, errors.New("account not found")
+	}
+	return a, nil
+}
+
+func (f *fakeServer) EstimateGas(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.EstimateGasResponse, error) {
+	if f.estimateGas == nil {
+		return f.UnimplementedApiServiceServer.EstimateGas(ctx, req)
+	}
+	return f.estimateGas(req)
+}
+
+// newTestSDK returns an sdk connected to a fake api server, using a new account as the publisher.
+func newTestSDK(t *testing.T) (*IOSTDevSDK, *fakeServer) {
+	server := &fakeServer{accounts: make(map[string]*rpcpb.Account)}
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	rpcpb.RegisterApiServiceServer(s, server)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+	assert.NoError(t, err)
+
+	sdk := NewIOSTDevSDK()
+	sdk.rpcConn = conn
+	sdk.SetAccount("user_0", kp)
+	t.Cleanup(sdk.CloseConn)
+	return sdk, server
+}
+
+func TestFillGasLimit(t *testing.T) {
+	estimated := &rpcpb.EstimateGasResponse{
+		GasUsage: 1000,
+		GasLimit: 1200,
+		Receipt:  &rpcpb.TxReceipt{StatusCode: rpcpb.TxReceipt_SUCCESS},
+	}
+	failed := &rpcpb.EstimateGasResponse{
+		Receipt: &rpcpb.TxReceipt{StatusCode: rpcpb.TxReceipt_RUNTIME_ERROR, Message: "failed"},
+	}
+	tests := []struct {
+		name     string
+		gasLimit float64
+		signed   bool
+		resp     *rpcpb.EstimateGasResponse
+		expected float64
+	}{
+		{"estimated", 0, false, estimated, 1200},
+		{"set by user", 500000, false, nil, 500000},
+		{"signed", 0, true, nil, 0},
+		{"estimation failed", 0, false, failed, DefaultGasLimit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sdk, server := newTestSDK(t)
+			if tt.resp != nil {
+				server.estimateGas = func(req *rpcpb.TransactionRequest) (*rpcpb.EstimateGasResponse, error) {
+					assert.Equal(t, "user_0", req.Publisher)
+					assert.NotEmpty(t, req.PublisherSigs)
+					return tt.resp, nil
+				}
+			}
+			sdk.SetTxInfo(tt.gasLimit, 1, 90, 0, nil)
+			trx, err := sdk.CreateTxFromActions([]*rpcpb.Action{NewAction("system.iost", "receipt", `["hello"]`)})
+			assert.NoError(t, err)
+			if tt.signed {
+				trx.PublisherSigs = []*rpcpb.Signature{{}}
+			}
+			sdk.fillGasLimit(trx)
+			assert.Equal(t, tt.expected, trx.GasLimit)
+			assert.Empty(t, trx.Publisher, "the tx isn't signed by the estimation")
+		})
+	}
+}
+
+func TestEstimateAmountLimit(t *testing.T) {
+	charged := []*rpcpb.AmountLimit{{Token: "iost", Value: "10"}, {Token: "ram", Value: "100"}}
+	tests := []struct {
+		name    string
+		resp    *rpcpb.EstimateGasResponse
+		confirm func([]*rpcpb.AmountLimit) bool
+		limit   []*rpcpb.AmountLimit
+		err     bool
+	}{
+		{"charged", &rpcpb.EstimateGasResponse{AmountLimit: charged, Receipt: &rpcpb.TxReceipt{StatusCode: rpcpb.TxReceipt_SUCCESS}}, nil, charged, false},
+		{"nothing charged", &rpcpb.EstimateGasResponse{Receipt: &rpcpb.TxReceipt{StatusCode: rpcpb.TxReceipt_SUCCESS}}, nil, nil, false},
+		{"confirmed", &rpcpb.EstimateGasResponse{AmountLimit: charged, Receipt: &rpcpb.TxReceipt{StatusCode: rpcpb.TxReceipt_SUCCESS}}, func([]*rpcpb.AmountLimit) bool { return true }, charged, false},
+		{"rejected", &rpcpb.EstimateGasResponse{AmountLimit: charged, Receipt: &rpcpb.TxReceipt{StatusCode: rpcpb.TxReceipt_SUCCESS}}, func([]*rpcpb.AmountLimit) bool { return false }, nil, true},
+		{"dry run failed", &rpcpb.EstimateGasResponse{Receipt: &rpcpb.TxReceipt{StatusCode: rpcpb.TxReceipt_BALANCE_NOT_ENOUGH, Message: "balance not enough"}}, nil, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sdk, server := newTestSDK(t)
+			server.estimateGas = func(req *rpcpb.TransactionRequest) (*rpcpb.EstimateGasResponse, error) {
+				assert.Len(t, req.AmountLimit, 1)
+				assert.Equal(t, "*", req.AmountLimit[0].Token)
+				assert.Equal(t, "unlimited", req.AmountLimit[0].Value)
+				assert.Len(t, req.PublisherSigs, 1)
+				return tt.resp, nil
+			}
+			sdk.SetTxInfo(DefaultGasLimit, 1, 90, 0, []*rpcpb.AmountLimit{})
+			sdk.SetInferAmountLimit(true, tt.confirm)
+			trx, err := sdk.CreateTxFromActions([]*rpcpb.Action{NewAction("token.iost", "transfer", `["iost","user_0","user_1","10",""]`)})
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, len(tt.limit), len(trx.AmountLimit))
+			for k, l := range tt.limit {
+				assert.Equal(t, l.Token, trx.AmountLimit[k].Token)
+				assert.Equal(t, l.Value, trx.AmountLimit[k].Value)
+			}
+			assert.Empty(t, trx.Publisher, "the created tx isn't signed by the dry run")
+			assert.Empty(t, trx.PublisherSigs)
+		})
+	}
+}
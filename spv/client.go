@@ -0,0 +1,267 @@
+package spv
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/core/merkletree"
+	"github.com/iost-official/go-iost/v3/core/tx"
+	"github.com/iost-official/go-iost/v3/db/kv"
+	"github.com/iost-official/go-iost/v3/ilog"
+)
+
+const (
+	headerBatch = 100
+	// witnessBlockNum is the number of blocks after an epoch block used to confirm it.
+	witnessBlockNum = 108
+)
+
+// error of spv client
+var (
+	ErrNotVerified = errors.New("block is not verified yet")
+)
+
+// Config is the config of spv client.
+type Config struct {
+	// DataPath is the dir to persist the verified header chain.
+	DataPath string
+	// StorageType is the storage engine of DataPath.
+	StorageType kv.StorageType
+	// SyncFromBlock is the trusted block to start from, it is rounded down to an epoch block.
+	// 0 means the last irreversible block of the source. It is ignored if DataPath has headers.
+	SyncFromBlock int64
+	// Interval is the interval to poll new blocks in Run.
+	Interval time.Duration
+}
+
+// Client is the spv light client. It follows the irreversible block headers from a trusted
+// epoch block, checks every header is signed by a producer of its epoch and tracks the producer rotation.
+type Client struct {
+	conf     *Config
+	source   Source
+	store    *store
+	verifier *Verifier
+	tip      *block.Block
+}
+
+// NewClient returns a spv client, it bootstraps from the trusted block if DataPath is empty.
+func NewClient(source Source, conf *Config) (*Client, error) {
+	if conf.StorageType == 0 {
+		conf.StorageType = kv.LevelDBStorage
+	}
+	if conf.Interval == 0 {
+		conf.Interval = 10 * time.Second
+	}
+	s, err := newStore(conf.DataPath, conf.StorageType)
+	if err != nil {
+		return nil, err
+	}
+	c := &Client{
+		conf:     conf,
+		source:   source,
+		store:    s,
+		verifier: &Verifier{},
+	}
+	if err := c.load(); err != nil {
+		s.close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// Close closes the local store.
+func (c *Client) Close() error {
+	return c.store.close()
+}
+
+func (c *Client) load() error {
+	number, err := c.store.tip()
+	if err != nil {
+		return err
+	}
+	if number < 0 {
+		return c.bootstrap()
+	}
+	tip, err := c.store.header(number)
+	if err != nil {
+		return err
+	}
+	epochs, err := c.store.epochs()
+	if err != nil {
+		return err
+	}
+	c.verifier.EpochProducer = epochs
+	c.verifier.CurrentProducer = epochs[epochOf(number+1)]
+	c.tip = tip
+	ilog.Infof("spv loaded, tip: %v", number)
+	return nil
+}
+
+func (c *Client) bootstrap() error {
+	syncFrom := c.conf.SyncFromBlock
+	if syncFrom == 0 {
+		lib, err := c.source.LibBlockNumber()
+		if err != nil {
+			return err
+		}
+		syncFrom = lib
+	}
+	syncFrom = syncFrom / common.VoteInterval * common.VoteInterval
+	blk, err := c.source.Block(syncFrom)
+	if err != nil {
+		return err
+	}
+	if err := checkContent(blk); err != nil {
+		return err
+	}
+	// we trust this block in spv mode
+	if err := c.verifier.init(blk); err != nil {
+		return err
+	}
+	if err := c.store.push(blk, c.verifier.CurrentProducer); err != nil {
+		return err
+	}
+	c.tip = blk
+	ilog.Infof("spv bootstrapped from block %v, hash: %v", syncFrom, common.Base58Encode(blk.HeadHash()))
+	return nil
+}
+
+// checkContent checks the txs and receipts of a complete block match the merkle roots in its head.
+func checkContent(blk *block.Block) error {
+	if err := blk.VerifySelf(); err != nil {
+		return fmt.Errorf("invalid block: %v", err)
+	}
+	if !bytes.Equal(blk.CalculateTxMerkleHash(), blk.Head.TxMerkleHash) {
+		return fmt.Errorf("tx merkle hash mismatch at block %v", blk.Head.Number)
+	}
+	if !bytes.Equal(blk.CalculateTxReceiptMerkleHash(), blk.Head.TxReceiptMerkleHash) {
+		return fmt.Errorf("receipt merkle hash mismatch at block %v", blk.Head.Number)
+	}
+	return nil
+}
+
+// Tip returns the highest verified block header.
+func (c *Client) Tip() *block.Block {
+	return c.tip
+}
+
+// Header returns the verified block header of the number.
+func (c *Client) Header(number int64) (*block.Block, error) {
+	if number > c.tip.Head.Number {
+		return nil, ErrNotVerified
+	}
+	return c.store.header(number)
+}
+
+// Run syncs headers until ctx is done.
+func (c *Client) Run(ctx context.Context) error {
+	for {
+		if _, err := c.Sync(); err != nil {
+			ilog.Warnf("spv sync failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.conf.Interval):
+		}
+	}
+}
+
+// Sync verifies and saves the headers up to the last irreversible block of the source.
+// It returns the number of the new tip.
+func (c *Client) Sync() (int64, error) {
+	lib, err := c.source.LibBlockNumber()
+	if err != nil {
+		return c.tip.Head.Number, err
+	}
+	for c.tip.Head.Number < lib {
+		start := c.tip.Head.Number + 1
+		end := start + headerBatch
+		if end > lib+1 {
+			end = lib + 1
+		}
+		headers, err := c.source.Headers(start, end)
+		if err != nil {
+			return c.tip.Head.Number, err
+		}
+		if len(headers) == 0 {
+			break
+		}
+		for _, h := range headers {
+			ok, err := c.push(h)
+			if err != nil {
+				return c.tip.Head.Number, err
+			}
+			if !ok {
+				return c.tip.Head.Number, nil
+			}
+		}
+	}
+	return c.tip.Head.Number, nil
+}
+
+// push verifies the header on the tip. It returns false if the header can not be confirmed yet.
+func (c *Client) push(h *block.Block) (bool, error) {
+	if err := c.verifier.checkHeader(h, c.tip); err != nil {
+		return false, err
+	}
+	var producers []string
+	if h.Head.Number%common.VoteInterval == 0 {
+		blk, err := c.source.Block(h.Head.Number)
+		if err != nil {
+			return false, err
+		}
+		if !bytes.Equal(blk.HeadHash(), h.HeadHash()) {
+			return false, fmt.Errorf("block hash mismatch at epoch block %v", h.Head.Number)
+		}
+		if err := checkContent(blk); err != nil {
+			return false, err
+		}
+		witnessBlocks, err := c.source.Headers(h.Head.Number+1, h.Head.Number+1+witnessBlockNum)
+		if err != nil {
+			return false, err
+		}
+		if len(witnessBlocks) < witnessBlockNum {
+			return false, nil
+		}
+		if err := c.verifier.updateEpoch(blk, witnessBlocks); err != nil {
+			return false, err
+		}
+		producers = c.verifier.CurrentProducer
+		ilog.Infof("spv epoch updated at block %v, producers: %v", h.Head.Number, producers)
+	}
+	if err := c.store.push(h, producers); err != nil {
+		return false, err
+	}
+	c.tip = h
+	return true, nil
+}
+
+// VerifyTx proves the tx and its receipt are included in a verified block by merkle proofs.
+// The proofs are checked against the tx and receipt merkle roots of the verified header, so no block is downloaded.
+func (c *Client) VerifyTx(txHash []byte) (*tx.TxReceipt, *block.BlockHead, error) {
+	proof, err := c.source.TxProof(txHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	header, err := c.Header(proof.BlockNumber)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !merkletree.VerifyMerkleProof(txHash, proof.TxIndex, proof.TxSiblings, header.Head.TxMerkleHash) {
+		return nil, nil, fmt.Errorf("invalid merkle proof of tx %v in block %v", common.Base58Encode(txHash), proof.BlockNumber)
+	}
+	r := proof.Receipt
+	if r == nil || !bytes.Equal(r.TxHash, txHash) {
+		return nil, nil, fmt.Errorf("receipt of tx %v mismatch", common.Base58Encode(txHash))
+	}
+	if !merkletree.VerifyMerkleProof(r.Hash(), proof.ReceiptIndex, proof.ReceiptSiblings, header.Head.TxReceiptMerkleHash) {
+		return nil, nil, fmt.Errorf("invalid merkle proof of receipt of tx %v in block %v", common.Base58Encode(txHash), proof.BlockNumber)
+	}
+	return r, header.Head, nil
+}
@@ -0,0 +1,250 @@
+package spv
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/iost-official/go-iost/v3/account"
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/core/blockcache"
+	"github.com/iost-official/go-iost/v3/core/merkletree"
+	"github.com/iost-official/go-iost/v3/core/tx"
+	"github.com/iost-official/go-iost/v3/crypto"
+	"github.com/iost-official/go-iost/v3/db/kv"
+	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSource struct {
+	blocks map[int64]*block.Block
+	txs    map[string]int64
+	lib    int64
+}
+
+func (s *mockSource) LibBlockNumber() (int64, error) {
+	return s.lib, nil
+}
+
+func (s *mockSource) Block(number int64) (*block.Block, error) {
+	blk, ok := s.blocks[number]
+	if !ok {
+		return nil, fmt.Errorf("block %v not found", number)
+	}
+	return blk, nil
+}
+
+func (s *mockSource) Headers(start, end int64) ([]*block.Block, error) {
+	blks := make([]*block.Block, 0)
+	for i := start; i < end; i++ {
+		blk, ok := s.blocks[i]
+		if !ok {
+			break
+		}
+		h := &block.Block{Head: blk.Head, Sign: blk.Sign}
+		h.CalculateHeadHash()
+		blks = append(blks, h)
+	}
+	return blks, nil
+}
+
+func (s *mockSource) TxProof(txHash []byte) (*TxProof, error) {
+	number, ok := s.txs[string(txHash)]
+	if !ok {
+		return nil, fmt.Errorf("tx not found")
+	}
+	blk := s.blocks[number]
+	hashes := make([][]byte, 0, len(blk.Txs))
+	for _, t := range blk.Txs {
+		hashes = append(hashes, t.Hash())
+	}
+	txTree := merkletree.MerkleTree{}
+	txTree.Build(hashes)
+	txIndex, txSiblings, err := txTree.MerkleProof(txHash)
+	if err != nil {
+		return nil, err
+	}
+	receiptTree := merkletree.TXRMerkleTree{}
+	receiptTree.Build(blk.Receipts)
+	_, receiptIndex, receiptSiblings, err := receiptTree.MerkleProof(txHash)
+	if err != nil {
+		return nil, err
+	}
+	var receipt *tx.TxReceipt
+	for _, r := range blk.Receipts {
+		if string(r.TxHash) == string(txHash) {
+			receipt = &tx.TxReceipt{}
+			receipt.Decode(r.Encode())
+		}
+	}
+	return &TxProof{
+		BlockNumber:     number,
+		TxIndex:         txIndex,
+		TxSiblings:      txSiblings,
+		Receipt:         receipt,
+		ReceiptIndex:    receiptIndex,
+		ReceiptSiblings: receiptSiblings,
+	}, nil
+}
+
+func newKeyPairs(t *testing.T, n int) []*account.KeyPair {
+	kps := make([]*account.KeyPair, 0, n)
+	for i := 0; i < n; i++ {
+		kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+		require.Nil(t, err)
+		kps = append(kps, kp)
+	}
+	return kps
+}
+
+func pubkeys(kps []*account.KeyPair) []string {
+	res := make([]string, 0, len(kps))
+	for _, kp := range kps {
+		res = append(res, kp.ReadablePubkey())
+	}
+	return res
+}
+
+// newMockSource generates blocks in [from, to], producers rotate at every epoch block.
+func newMockSource(t *testing.T, from, to int64) (*mockSource, *tx.Tx) {
+	s := &mockSource{
+		blocks: make(map[int64]*block.Block),
+		txs:    make(map[string]int64),
+		lib:    to,
+	}
+	current := newKeyPairs(t, VerifierNum)
+	var pending []*account.KeyPair
+	var parent []byte
+	var userTx *tx.Tx
+	for n := from; n <= to; n++ {
+		if n%common.VoteInterval == 0 {
+			if n == from {
+				pending = current
+			} else {
+				current = pending
+				pending = append(append([]*account.KeyPair{}, current[1:]...), newKeyPairs(t, 1)...)
+			}
+		} else if n%common.VoteInterval == 1 {
+			current = pending
+		}
+		witness := current[(n/6)%int64(len(current))]
+		blk := &block.Block{
+			Head: &block.BlockHead{
+				Number:     n,
+				ParentHash: parent,
+				Witness:    witness.ReadablePubkey(),
+				Time:       n,
+			},
+			Txs:      []*tx.Tx{},
+			Receipts: []*tx.TxReceipt{},
+		}
+		if n%common.VoteInterval == 0 || n == from+10 {
+			t := tx.NewTx([]*tx.Action{tx.NewAction("vote_producer.iost", "stat", "[]")}, nil, 100000, 100, n, 0, 0)
+			r := tx.NewTxReceipt(t.Hash())
+			if n%common.VoteInterval == 0 {
+				content, _ := json.Marshal(&blockcache.WitnessStatus{PendingList: pubkeys(pending)})
+				r.Receipts = append(r.Receipts, &tx.Receipt{FuncName: "vote_producer.iost/stat", Content: string(content)})
+			} else {
+				userTx = t
+			}
+			blk.Txs = append(blk.Txs, t)
+			blk.Receipts = append(blk.Receipts, r)
+			s.txs[string(t.Hash())] = n
+		}
+		blk.Head.TxMerkleHash = blk.CalculateTxMerkleHash()
+		blk.Head.TxReceiptMerkleHash = blk.CalculateTxReceiptMerkleHash()
+		blk.CalculateHeadHash()
+		blk.Sign = witness.Sign(blk.HeadHash())
+		s.blocks[n] = blk
+		parent = blk.HeadHash()
+	}
+	return s, userTx
+}
+
+func TestClient(t *testing.T) {
+	from := common.VoteInterval
+	to := 2*common.VoteInterval + witnessBlockNum + 10
+	source, userTx := newMockSource(t, from, to)
+	conf := &Config{
+		DataPath:      "spv_test",
+		StorageType:   kv.MemoryStorage,
+		SyncFromBlock: from + 5,
+	}
+	defer kv.RemoveStorage(conf.DataPath, conf.StorageType)
+
+	c, err := NewClient(source, conf)
+	require.Nil(t, err)
+	require.Equal(t, from, c.Tip().Head.Number)
+	_, _, err = c.VerifyTx(userTx.Hash())
+	require.Equal(t, ErrNotVerified, err)
+
+	tip, err := c.Sync()
+	require.Nil(t, err)
+	require.Equal(t, to, tip)
+	require.Len(t, c.verifier.EpochProducer, 2)
+
+	receipt, head, err := c.VerifyTx(userTx.Hash())
+	require.Nil(t, err)
+	require.Equal(t, userTx.Hash(), receipt.TxHash)
+	require.Equal(t, from+10, head.Number)
+
+	// the receipt is forged
+	r := source.blocks[from+10].Receipts[0]
+	r.Status = &tx.Status{Code: tx.ErrorRuntime, Message: "forged"}
+	_, _, err = c.VerifyTx(userTx.Hash())
+	require.NotNil(t, err)
+	r.Status = &tx.Status{Code: tx.Success}
+	// reload from the local store
+	require.Nil(t, c.Close())
+	c, err = NewClient(source, conf)
+	require.Nil(t, err)
+	require.Equal(t, to, c.Tip().Head.Number)
+	require.Len(t, c.verifier.EpochProducer, 2)
+	require.Nil(t, c.Close())
+}
+
+func TestToCoreReceipt(t *testing.T) {
+	r := tx.NewTxReceipt([]byte("tx hash"))
+	r.GasUsage = 123456789
+	r.RAMUsage["alice"] = 100
+	r.Status = &tx.Status{Code: tx.ErrorRuntime, Message: "failed"}
+	r.Returns = []string{`["ok"]`}
+	r.Receipts = []*tx.Receipt{{FuncName: "token.iost/transfer", Content: "[]"}}
+	pb := &rpcpb.TxReceipt{
+		TxHash:     common.Base58Encode(r.TxHash),
+		GasUsage:   float64(r.GasUsage) / 100,
+		RamUsage:   r.RAMUsage,
+		StatusCode: rpcpb.TxReceipt_StatusCode(r.Status.Code),
+		Message:    r.Status.Message,
+		Returns:    r.Returns,
+		Receipts:   []*rpcpb.TxReceipt_Receipt{{FuncName: "token.iost/transfer", Content: "[]"}},
+	}
+	require.Equal(t, r.Hash(), toCoreReceipt(pb).Hash())
+}
+
+func TestClientRejectsForgedHeader(t *testing.T) {
+	from := common.VoteInterval
+	to := from + 50
+	source, _ := newMockSource(t, from, to)
+	forger := newKeyPairs(t, 1)[0]
+	blk := source.blocks[from+20]
+	blk.Head.Witness = forger.ReadablePubkey()
+	blk.CalculateHeadHash()
+	blk.Sign = forger.Sign(blk.HeadHash())
+
+	conf := &Config{
+		DataPath:    "spv_test_forged",
+		StorageType: kv.MemoryStorage,
+	}
+	defer kv.RemoveStorage(conf.DataPath, conf.StorageType)
+	source.lib = from
+	c, err := NewClient(source, conf)
+	require.Nil(t, err)
+	defer c.Close()
+
+	source.lib = to
+	tip, err := c.Sync()
+	require.NotNil(t, err)
+	require.Equal(t, from+19, tip)
+}
@@ -0,0 +1,158 @@
+package spv
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/core/tx"
+	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Source is where the spv client fetches blocks from.
+type Source interface {
+	// LibBlockNumber returns the last irreversible block number.
+	LibBlockNumber() (int64, error)
+	// Block returns the complete block of the number.
+	Block(number int64) (*block.Block, error)
+	// Headers returns the block headers in [start, end), it may return less than requested.
+	Headers(start, end int64) ([]*block.Block, error)
+	// TxProof returns the merkle proofs of the tx and its receipt.
+	TxProof(txHash []byte) (*TxProof, error)
+}
+
+// TxProof is the merkle proofs of a tx and its receipt in a block, which are checked by the block header.
+type TxProof struct {
+	BlockNumber     int64
+	TxIndex         int32
+	TxSiblings      [][]byte
+	Receipt         *tx.TxReceipt
+	ReceiptIndex    int32
+	ReceiptSiblings [][]byte
+}
+
+// RPCSource is the Source backed by a full node's grpc api.
+type RPCSource struct {
+	conn   *grpc.ClientConn
+	client rpcpb.ApiServiceClient
+}
+
+// NewRPCSource returns a Source connected to the grpc server.
+func NewRPCSource(server string) (*RPCSource, error) {
+	conn, err := grpc.NewClient(server, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &RPCSource{
+		conn:   conn,
+		client: rpcpb.NewApiServiceClient(conn),
+	}, nil
+}
+
+// Close closes the grpc connection.
+func (s *RPCSource) Close() error {
+	return s.conn.Close()
+}
+
+// LibBlockNumber returns the last irreversible block number.
+func (s *RPCSource) LibBlockNumber() (int64, error) {
+	info, err := s.client.GetChainInfo(context.Background(), &rpcpb.EmptyRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return info.LibBlock, nil
+}
+
+// Block returns the complete block of the number.
+func (s *RPCSource) Block(number int64) (*block.Block, error) {
+	res, err := s.client.GetRawBlockByNumber(context.Background(), &rpcpb.GetBlockByNumberRequest{Number: number, Complete: true})
+	if err != nil {
+		return nil, err
+	}
+	blk := &block.Block{}
+	blk.FromPb(res.Block)
+	return blk, nil
+}
+
+// Headers returns the block headers in [start, end).
+func (s *RPCSource) Headers(start, end int64) ([]*block.Block, error) {
+	res, err := s.client.GetBlockHeaderByRange(context.Background(), &rpcpb.GetBlockHeaderByRangeRequest{
+		Start: start,
+		End:   end,
+	})
+	if err != nil {
+		return nil, err
+	}
+	blks := make([]*block.Block, 0, len(res.BlockList))
+	for _, item := range res.BlockList {
+		b := &block.Block{}
+		b.FromPb(item)
+		blks = append(blks, b)
+	}
+	return blks, nil
+}
+
+// TxProof returns the merkle proofs of the tx and its receipt, which are only served for irreversible blocks.
+func (s *RPCSource) TxProof(txHash []byte) (*TxProof, error) {
+	req := &rpcpb.TxHashRequest{Hash: common.Base58Encode(txHash)}
+	txProof, err := s.client.GetTxProof(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	receiptProof, err := s.client.GetReceiptProof(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	if txProof.BlockNumber != receiptProof.BlockNumber {
+		return nil, fmt.Errorf("block number of tx proof %v mismatch receipt proof %v", txProof.BlockNumber, receiptProof.BlockNumber)
+	}
+	receipt, err := s.client.GetTxReceiptByTxHash(context.Background(), &rpcpb.TxHashRequest{Hash: req.Hash})
+	if err != nil {
+		return nil, err
+	}
+	return &TxProof{
+		BlockNumber:     txProof.BlockNumber,
+		TxIndex:         int32(txProof.Index),
+		TxSiblings:      decodeHashes(txProof.Siblings),
+		Receipt:         toCoreReceipt(receipt),
+		ReceiptIndex:    int32(receiptProof.Index),
+		ReceiptSiblings: decodeHashes(receiptProof.Siblings),
+	}, nil
+}
+
+func decodeHashes(hashes []string) [][]byte {
+	res := make([][]byte, 0, len(hashes))
+	for _, h := range hashes {
+		res = append(res, common.Base58Decode(h))
+	}
+	return res
+}
+
+// toCoreReceipt converts the rpc receipt back, whose hash is then checked by the receipt merkle proof.
+func toCoreReceipt(r *rpcpb.TxReceipt) *tx.TxReceipt {
+	receipt := &tx.TxReceipt{
+		TxHash:   common.Base58Decode(r.TxHash),
+		GasUsage: int64(math.Round(r.GasUsage * 100)),
+		RAMUsage: r.RamUsage,
+		Status: &tx.Status{
+			Code:    tx.StatusCode(r.StatusCode),
+			Message: r.Message,
+		},
+		Returns:  r.Returns,
+		Receipts: make([]*tx.Receipt, 0, len(r.Receipts)),
+	}
+	if receipt.RAMUsage == nil {
+		receipt.RAMUsage = make(map[string]int64)
+	}
+	if receipt.Returns == nil {
+		receipt.Returns = []string{}
+	}
+	for _, re := range r.Receipts {
+		receipt.Receipts = append(receipt.Receipts, &tx.Receipt{FuncName: re.FuncName, Content: re.Content})
+	}
+	return receipt
+}
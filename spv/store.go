@@ -0,0 +1,112 @@
+package spv
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/db/kv"
+)
+
+var (
+	tipKey       = []byte("tip")
+	headerPrefix = []byte("h") // headerPrefix + block number -> block header and sign
+	epochPrefix  = []byte("e") // epochPrefix + epoch block number -> producer list
+)
+
+func numberKey(prefix []byte, number int64) []byte {
+	return append(append([]byte{}, prefix...), common.Int64ToBytes(number)...)
+}
+
+// store persists the verified header chain and the producers of each epoch.
+type store struct {
+	db *kv.Storage
+}
+
+func newStore(path string, t kv.StorageType) (*store, error) {
+	db, err := kv.NewStorage(path, t)
+	if err != nil {
+		return nil, fmt.Errorf("fail to init spv db, %v", err)
+	}
+	return &store{db: db}, nil
+}
+
+func (s *store) close() error {
+	return s.db.Close()
+}
+
+// tip returns the number of the highest verified header, -1 if empty.
+func (s *store) tip() (int64, error) {
+	b, err := s.db.Get(tipKey)
+	if err != nil {
+		return 0, err
+	}
+	if len(b) == 0 {
+		return -1, nil
+	}
+	return common.BytesToInt64(b), nil
+}
+
+func (s *store) header(number int64) (*block.Block, error) {
+	b, err := s.db.Get(numberKey(headerPrefix, number))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, fmt.Errorf("header %v not found", number)
+	}
+	blk := &block.Block{}
+	if err := blk.Decode(b); err != nil {
+		return nil, err
+	}
+	return blk, nil
+}
+
+func (s *store) epochs() (map[int64][]string, error) {
+	keys, err := s.db.Keys(epochPrefix)
+	if err != nil {
+		return nil, err
+	}
+	epochs := make(map[int64][]string, len(keys))
+	for _, k := range keys {
+		b, err := s.db.Get(k)
+		if err != nil {
+			return nil, err
+		}
+		var producers []string
+		if err := json.Unmarshal(b, &producers); err != nil {
+			return nil, err
+		}
+		epochs[common.BytesToInt64(k[len(epochPrefix):])] = producers
+	}
+	return epochs, nil
+}
+
+// push saves the verified header as the new tip, with the producers if it is an epoch block.
+func (s *store) push(blk *block.Block, producers []string) error {
+	h := &block.Block{Head: blk.Head, Sign: blk.Sign}
+	b, err := h.EncodeM()
+	if err != nil {
+		return err
+	}
+	if err := s.db.BeginBatch(); err != nil {
+		return err
+	}
+	if err := s.db.Put(numberKey(headerPrefix, blk.Head.Number), b); err != nil {
+		return err
+	}
+	if producers != nil {
+		p, err := json.Marshal(producers)
+		if err != nil {
+			return err
+		}
+		if err := s.db.Put(numberKey(epochPrefix, blk.Head.Number), p); err != nil {
+			return err
+		}
+	}
+	if err := s.db.Put(tipKey, common.Int64ToBytes(blk.Head.Number)); err != nil {
+		return err
+	}
+	return s.db.CommitBatch()
+}
@@ -1,4 +1,4 @@
-package main
+package spv
 
 import (
 	"bytes"
@@ -9,8 +9,13 @@ import (
 	"github.com/iost-official/go-iost/v3/core/blockcache"
 )
 
+// VerifierNum is the number of producers in an epoch.
 const VerifierNum = 17
 
+// witnessNum is the number of distinct producers needed to confirm a block (2/3 * 17).
+const witnessNum = 12
+
+// Verifier tracks producer rotation and checks blocks against the producers of their epoch.
 type Verifier struct {
 	CurrentProducer []string
 	EpochProducer   map[int64][]string
@@ -34,6 +39,45 @@ func (v *Verifier) init(blk *block.Block) error {
 	return nil
 }
 
+// epochOf returns the epoch block whose pending list produces the block.
+func epochOf(blockNumber int64) int64 {
+	if blockNumber%common.VoteInterval == 0 {
+		return blockNumber - common.VoteInterval
+	}
+	return blockNumber / common.VoteInterval * common.VoteInterval
+}
+
+func (v *Verifier) producersOf(blockNumber int64) ([]string, error) {
+	producers, ok := v.EpochProducer[epochOf(blockNumber)]
+	if !ok {
+		return nil, fmt.Errorf("cannot find producer info of epoch %v for block %v", epochOf(blockNumber), blockNumber)
+	}
+	return producers, nil
+}
+
+// checkHeader checks the block is signed by a producer of its epoch and links to the parent.
+func (v *Verifier) checkHeader(blk *block.Block, parent *block.Block) error {
+	if blk.VerifySelf() != nil {
+		return fmt.Errorf("invalid block signature at block %v", blk.Head.Number)
+	}
+	if blk.Head.Number != parent.Head.Number+1 {
+		return fmt.Errorf("invalid block number at block %v", blk.Head.Number)
+	}
+	if !bytes.Equal(blk.Head.ParentHash, parent.HeadHash()) {
+		return fmt.Errorf("invalid block hash at block %v", blk.Head.Number)
+	}
+	producers, err := v.producersOf(blk.Head.Number)
+	if err != nil {
+		return err
+	}
+	for _, p := range producers {
+		if p == blk.Head.Witness {
+			return nil
+		}
+	}
+	return fmt.Errorf("witness %v is not a producer at block %v", blk.Head.Witness, blk.Head.Number)
+}
+
 func (v *Verifier) checkWitness(blk *block.Block, witnessBlocks []*block.Block) error {
 	if blk.VerifySelf() != nil {
 		return fmt.Errorf("invalid block signature")
@@ -45,15 +89,9 @@ func (v *Verifier) checkWitness(blk *block.Block, witnessBlocks []*block.Block)
 	}
 	blockNumber := blk.Head.Number
 	// we should check this blk is verified by more than 2/3 of current validators
-	var currentEpochStartBlock int64
-	if blockNumber%common.VoteInterval == 0 {
-		currentEpochStartBlock = blockNumber - common.VoteInterval
-	} else {
-		currentEpochStartBlock = blockNumber / common.VoteInterval * common.VoteInterval
-	}
-	currentProducer, succ := v.EpochProducer[currentEpochStartBlock]
-	if !succ {
-		return fmt.Errorf("cannot update producer list at block %v: cannot find producer info of previous epoch", blockNumber)
+	currentProducer, err := v.producersOf(blockNumber)
+	if err != nil {
+		return fmt.Errorf("cannot update producer list at block %v: %v", blockNumber, err)
 	}
 	var validWitness = make(map[string]bool)
 	var validWitnessCount = 0
@@ -80,8 +118,7 @@ func (v *Verifier) checkWitness(blk *block.Block, witnessBlocks []*block.Block)
 		parentBlockNumber = b.Head.Number
 		parentHash = b.HeadHash()
 	}
-	// we need 12 (2/3 * 17) witness to confirm a block
-	if validWitnessCount < 12 {
+	if validWitnessCount < witnessNum {
 		return fmt.Errorf("valid witness not enough %v", validWitness)
 	}
 	return nil
@@ -103,15 +140,7 @@ func (v *Verifier) updateEpoch(blk *block.Block, witnessBlocks []*block.Block) e
 	if err != nil {
 		return err
 	}
+	v.CurrentProducer = w.PendingList
 	v.EpochProducer[voteBlockNumber] = w.PendingList
 	return nil
 }
-
-func (v *Verifier) checkBlock(blk *block.Block, witnessBlocks []*block.Block) error {
-	err := v.checkWitness(blk, witnessBlocks)
-	if err != nil {
-		return err
-	}
-	fmt.Printf("check block %v done\n", blk.Head.Number)
-	return nil
-}
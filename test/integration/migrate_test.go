@@ -0,0 +1,75 @@
+package integration
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/iost-official/go-iost/v3/core/tx"
+	"github.com/iost-official/go-iost/v3/ilog"
+	. "github.com/iost-official/go-iost/v3/verifier"
+	"github.com/stretchr/testify/assert"
+)
+
+func updateContract(t *testing.T, s *Simulator, cname, file string) *tx.TxReceipt {
+	c, err := s.Compile(cname, "./test_data/"+file, "./test_data/"+file)
+	assert.Nil(t, err)
+	code, err := json.Marshal(c)
+	assert.Nil(t, err)
+	args, err := json.Marshal([]string{string(code), ""})
+	assert.Nil(t, err)
+	r, err := s.Call("system.iost", "updateCode", string(args), acc0.ID, acc0.KeyPair)
+	assert.Nil(t, err)
+	return r
+}
+
+func contractVersion(t *testing.T, s *Simulator, cname string) string {
+	r, err := s.Call(cname, "version", `[]`, acc0.ID, acc0.KeyPair)
+	assert.Nil(t, err)
+	assert.Equal(t, tx.Success, r.Status.Code)
+	return r.Returns[0]
+}
+
+func Test_Migrate(t *testing.T) {
+	ilog.Stop()
+	tests := []struct {
+		name    string
+		file    string
+		success bool
+		version string
+	}{
+		{"migrate is called after update", "migrate_v2", true, `["2"]`},
+		{"migrate with args is rejected", "migrate_args", false, `["1"]`},
+		{"failing migrate rolls back the update", "migrate_fail", false, `["1"]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSimulator()
+			defer s.Clear()
+			createAccountsWithResource(s)
+
+			ca, err := s.Compile("", "./test_data/migrate", "./test_data/migrate")
+			assert.Nil(t, err)
+			cname, r, err := s.DeployContract(ca, acc0.ID, acc0.KeyPair)
+			assert.Nil(t, err)
+			assert.Equal(t, tx.Success, r.Status.Code)
+			assert.Equal(t, `["1"]`, contractVersion(t, s, cname))
+			assert.EqualValues(t, 1, s.Visitor.ContractVersionCount(cname))
+
+			r = updateContract(t, s, cname, tt.file)
+			if !tt.success {
+				assert.NotEqual(t, tx.Success, r.Status.Code)
+				assert.Nil(t, s.Visitor.Contract(cname).ABI("migrate"), "the code isn't updated")
+				assert.EqualValues(t, 1, s.Visitor.ContractVersionCount(cname))
+			} else {
+				assert.Equal(t, tx.Success, r.Status.Code, r.Status.Message)
+				assert.NotNil(t, s.Visitor.Contract(cname).ABI("migrate"))
+				assert.EqualValues(t, 2, s.Visitor.ContractVersionCount(cname))
+			}
+			assert.Equal(t, tt.version, contractVersion(t, s, cname))
+
+			r, err = s.Call(cname, "migrate", `[]`, acc0.ID, acc0.KeyPair)
+			assert.Nil(t, err)
+			assert.Contains(t, r.Status.Message, "cannot call 'migrate' manually")
+		})
+	}
+}
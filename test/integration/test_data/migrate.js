@@ -0,0 +1,13 @@
+class Contract {
+    init() {
+        storage.put("version", "1");
+    }
+    version() {
+        return storage.get("version");
+    }
+    can_update(data) {
+        return true;
+    }
+}
+
+module.exports = Contract;
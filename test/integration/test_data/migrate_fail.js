@@ -0,0 +1,17 @@
+class Contract {
+    init() {
+        storage.put("version", "1");
+    }
+    migrate() {
+        storage.put("version", "3");
+        throw new Error("migrate failed");
+    }
+    version() {
+        return storage.get("version");
+    }
+    can_update(data) {
+        return true;
+    }
+}
+
+module.exports = Contract;
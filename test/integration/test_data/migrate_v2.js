@@ -0,0 +1,16 @@
+class Contract {
+    init() {
+        storage.put("version", "1");
+    }
+    migrate() {
+        storage.put("version", "2");
+    }
+    version() {
+        return storage.get("version");
+    }
+    can_update(data) {
+        return true;
+    }
+}
+
+module.exports = Contract;
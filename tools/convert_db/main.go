@@ -0,0 +1,107 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/iost-official/go-iost/v3/db/kv"
+)
+
+const batchSize = 10000
+
+func convertDb(from string, fromType kv.StorageType, to string, toType kv.StorageType) error {
+	src, err := kv.NewStorage(from, fromType)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := kv.NewStorage(to, toType)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	if err := dst.BeginBatch(); err != nil {
+		return err
+	}
+	var count int64
+	iter := src.NewIteratorByPrefix([]byte(""))
+	for iter.Next() {
+		if err := dst.Put(iter.Key(), iter.Value()); err != nil {
+			iter.Release()
+			return err
+		}
+		count++
+		if count%batchSize == 0 {
+			if err := dst.CommitBatch(); err != nil {
+				iter.Release()
+				return err
+			}
+			if err := dst.BeginBatch(); err != nil {
+				iter.Release()
+				return err
+			}
+			fmt.Printf("%v: %v keys copied\n", filepath.Base(from), count)
+		}
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	if err := dst.CommitBatch(); err != nil {
+		return err
+	}
+	fmt.Printf("%v: %v keys copied\n", filepath.Base(from), count)
+	return verifyDb(dst, count)
+}
+
+func verifyDb(dst *kv.Storage, count int64) error {
+	var n int64
+	iter := dst.NewIteratorByPrefix([]byte(""))
+	defer iter.Release()
+	for iter.Next() {
+		n++
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	if n != count {
+		return fmt.Errorf("key count mismatch, src %v, dst %v", count, n)
+	}
+	return nil
+}
+
+func main() {
+	var from = flag.String("from", "/data/iserver/storage", "source storage dir")
+	var fromEngine = flag.String("from_engine", "leveldb", "storage engine of source, leveldb or pebble")
+	var to = flag.String("to", "./storage", "destination storage dir")
+	var toEngine = flag.String("to_engine", "leveldb", "storage engine of destination, leveldb or pebble")
+	flag.Parse()
+
+	fromType, err := kv.ParseStorageType(*fromEngine)
+	if err != nil {
+		panic(err)
+	}
+	toType, err := kv.ParseStorageType(*toEngine)
+	if err != nil {
+		panic(err)
+	}
+	if err := os.MkdirAll(*to, os.ModePerm); err != nil {
+		panic(err)
+	}
+	for _, name := range []string{"BlockChainDB", "StateDB"} {
+		src := filepath.Join(*from, name)
+		if _, err := os.Stat(src); err != nil {
+			fmt.Println("skip", src, err)
+			continue
+		}
+		err := convertDb(src, fromType, filepath.Join(*to, name), toType)
+		if err != nil {
+			panic(err)
+		}
+	}
+	fmt.Println("convert done")
+}
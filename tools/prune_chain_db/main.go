@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bytes"
 	"flag"
 	"fmt"
 	"os"
@@ -8,12 +9,11 @@ import (
 	"time"
 
 	"github.com/iost-official/go-iost/v3/core/block"
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/iost-official/go-iost/v3/db/kv"
 )
 
-func pruneStateDb(from, to string) error {
-	db, err := leveldb.OpenFile(from, nil)
+func pruneStateDb(from, to string, storageType kv.StorageType) error {
+	db, err := kv.NewStorage(from, storageType)
 	if err != nil {
 		return err
 	}
@@ -21,29 +21,22 @@ func pruneStateDb(from, to string) error {
 		db.Close()
 	}()
 
-	db2, err := leveldb.OpenFile(to, nil)
+	db2, err := kv.NewStorage(to, storageType)
 	if err != nil {
 		return err
 	}
 
-	range1 := &util.Range{Start: nil, Limit: []byte("state/b-base.iost-chain_infn")}
-	iter1 := db.NewIterator(range1, nil)
-	for iter1.Next() {
-		db2.Put(iter1.Key(), iter1.Value(), nil)
-	}
-	iter1.Release()
-	err = iter1.Error()
-	if err != nil {
-		return err
-	}
-
-	range2 := &util.Range{Start: []byte("state/b-base.iost-chain_infp"), Limit: nil}
-	iter2 := db.NewIterator(range2, nil)
-	for iter2.Next() {
-		db2.Put(iter2.Key(), iter2.Value(), nil)
+	// skip the keys in [state/b-base.iost-chain_infn, state/b-base.iost-chain_infp)
+	start, limit := []byte("state/b-base.iost-chain_infn"), []byte("state/b-base.iost-chain_infp")
+	iter := db.NewIteratorByPrefix(nil)
+	for iter.Next() {
+		if bytes.Compare(iter.Key(), start) >= 0 && bytes.Compare(iter.Key(), limit) < 0 {
+			continue
+		}
+		db2.Put(iter.Key(), iter.Value())
 	}
-	iter2.Release()
-	err = iter2.Error()
+	iter.Release()
+	err = iter.Error()
 	if err != nil {
 		return err
 	}
@@ -53,7 +46,7 @@ func pruneStateDb(from, to string) error {
 	if err != nil {
 		return err
 	}
-	db2, err = leveldb.OpenFile(to, nil)
+	db2, err = kv.NewStorage(to, storageType)
 	defer func() {
 		db2.Close()
 	}()
@@ -64,8 +57,8 @@ func pruneStateDb(from, to string) error {
 	return nil
 }
 
-func pruneHistoryDb(from, to string, offset int64) error {
-	chainDB, err := block.NewBlockChain(from)
+func pruneHistoryDb(from, to string, offset int64, storageType kv.StorageType) error {
+	chainDB, err := block.NewBlockChainWithStorage(from, storageType)
 	if err != nil {
 		fmt.Println("cannot load chain", err)
 		return err
@@ -95,18 +88,23 @@ func main() {
 	var to = flag.String("to", "./storage", "")
 	var pruneHistoryOffset = flag.Int("offset", 0, "")
 	var pruneState = flag.Bool("state", false, "")
+	var engine = flag.String("engine", "leveldb", "storage engine, leveldb or pebble")
 	flag.Parse()
 
+	storageType, err := kv.ParseStorageType(*engine)
+	if err != nil {
+		panic(err)
+	}
 	ensureDir(*to)
 
 	if *pruneHistoryOffset != 0 {
-		err := pruneHistoryDb(*from+"/BlockChainDB", *to+"/BlockChainDB", int64(*pruneHistoryOffset))
+		err := pruneHistoryDb(*from+"/BlockChainDB", *to+"/BlockChainDB", int64(*pruneHistoryOffset), storageType)
 		if err != nil {
 			panic(err)
 		}
 	}
 	if *pruneState {
-		err := pruneStateDb(*from+"/StateDB", *to+"/StateDB")
+		err := pruneStateDb(*from+"/StateDB", *to+"/StateDB", storageType)
 		if err != nil {
 			panic(err)
 		}
@@ -1,12 +1,13 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"sort"
 	"strings"
 
 	"github.com/iost-official/go-iost/v3/common"
-	"github.com/iost-official/go-iost/v3/db/kv/leveldb"
+	"github.com/iost-official/go-iost/v3/db/kv"
 	"github.com/iost-official/go-iost/v3/vm/database"
 )
 
@@ -17,7 +18,7 @@ func padTo(s string, ptn string, l int) string {
 	return s
 }
 
-func printTokenBalance(db *leveldb.DB, tokenType string) {
+func printTokenBalance(db *kv.Storage, tokenType string) {
 	fmt.Println("############# ", tokenType, " balance ##############")
 	prefix := "state/m-token.iost-TB"
 	keys, err := db.Keys([]byte(prefix))
@@ -88,9 +89,9 @@ func printTokenBalance(db *leveldb.DB, tokenType string) {
 	fmt.Println()
 }
 
-func printAll(db *leveldb.DB) { // nolint
+func printAll(db *kv.Storage) { // nolint
 	fmt.Println("######## all kvs #############")
-	iter := db.NewIteratorByPrefix([]byte("state/")).(*leveldb.Iter)
+	iter := db.NewIteratorByPrefix([]byte("state/"))
 	for iter.Next() {
 		k := string(iter.Key())
 		v := string(iter.Value())
@@ -101,10 +102,10 @@ func printAll(db *leveldb.DB) { // nolint
 	}
 }
 
-func printRAMUsage(db *leveldb.DB) {
+func printRAMUsage(db *kv.Storage) {
 	fmt.Println("######## system ram usage #############")
 	m := make(map[string]int)
-	iter := db.NewIteratorByPrefix([]byte("state/")).(*leveldb.Iter)
+	iter := db.NewIteratorByPrefix([]byte("state/"))
 	for iter.Next() {
 		k := string(iter.Key())
 		v := string(iter.Value())
@@ -194,8 +195,14 @@ func printRAMUsage(db *leveldb.DB) {
 }
 
 func main() {
+	var engine = flag.String("engine", "leveldb", "storage engine, leveldb or pebble")
+	flag.Parse()
+	storageType, err := kv.ParseStorageType(*engine)
+	if err != nil {
+		panic(err)
+	}
 	storagePath := "storage/StateDB"
-	db, err := leveldb.NewDB(storagePath)
+	db, err := kv.NewStorage(storagePath, storageType)
 	defer func() {
 		db.Close()
 	}()
@@ -1,6 +1,8 @@
 package verifier
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"time"
 
@@ -13,6 +15,18 @@ import (
 	"github.com/iost-official/go-iost/v3/ilog"
 	"github.com/iost-official/go-iost/v3/vm"
 	"github.com/iost-official/go-iost/v3/vm/database"
+	"github.com/iost-official/go-iost/v3/vm/host"
+)
+
+const (
+	// maxDeferTxsInBlock is the max number of scheduled delay txs settled in a block.
+	maxDeferTxsInBlock = 20
+)
+
+var (
+	errNoRangeQuery  = errors.New("db doesn't support range query of delay txs")
+	errDeferTxOrder  = errors.New("deferred txs should follow the block base tx")
+	errMissedDeferTx = errors.New("due deferred tx is not in block")
 )
 
 type Executor struct {
@@ -43,29 +57,112 @@ func (v *Executor) Exec(bh *block.BlockHead, db database.IMultiValue, t *tx.Tx,
 
 // Try exec tx and only return receipt
 func (v *Executor) Try(bh *block.BlockHead, db database.IMultiValue, t *tx.Tx, limit time.Duration) (*tx.TxReceipt, error) {
+	r, _, err := v.Estimate(bh, db, t, limit)
+	return r, err
+}
+
+// Estimate exec tx like Try, and also returns the amounts of tokens charged against the amount limit of the tx
+func (v *Executor) Estimate(bh *block.BlockHead, db database.IMultiValue, t *tx.Tx, limit time.Duration) (*tx.TxReceipt, map[string]*common.Decimal, error) {
 	var isolator vm.Isolator
 	vi := database.NewVisitor(100, db, bh.Rules())
 	var l ilog.Logger
 	l.Stop()
 	err := isolator.Prepare(bh, vi, &l)
 	if err != nil {
-		return &tx.TxReceipt{}, err
+		return &tx.TxReceipt{}, nil, err
 	}
 	err = isolator.PrepareTx(t, limit)
 	if err != nil {
-		return &tx.TxReceipt{}, err
+		return &tx.TxReceipt{}, nil, err
 	}
 	_, err = isolator.Run()
 	if err != nil {
-		return &tx.TxReceipt{}, err
+		return &tx.TxReceipt{}, nil, err
 	}
+	amounts := isolator.AmountTotal()
 	r, err := isolator.PayCost()
-	return r, err
+	if err != nil {
+		return r, nil, err
+	}
+	r.StateDiff = isolator.StateDiff()
+	return r, amounts, nil
+}
+
+// Trace exec tx on db without changing it, and returns the receipt and traced calls
+func (v *Executor) Trace(bh *block.BlockHead, db database.IMultiValue, t *tx.Tx, limit time.Duration) (*tx.TxReceipt, []*host.CallFrame, error) {
+	var isolator vm.Isolator
+	vi := database.NewVisitor(100, newRWSetDB(db), bh.Rules())
+	err := isolator.Prepare(bh, vi, getLogger(false))
+	if err != nil {
+		return nil, nil, err
+	}
+	return traceTx(&isolator, t, limit)
+}
+
+// TraceInBlock replays the block on the state of its parent until the tx of hash, then returns the receipt and traced calls of the tx.
+// db isn't changed.
+func (v *Executor) TraceInBlock(blk *block.Block, db database.IMultiValue, hash []byte, c *Config) (*tx.TxReceipt, []*host.CallFrame, error) {
+	idx := -1
+	for k, t := range blk.Txs {
+		if bytes.Equal(t.Hash(), hash) {
+			idx = k
+			break
+		}
+	}
+	if idx < 0 {
+		return nil, nil, fmt.Errorf("tx %v not found in block %v", common.Base58Encode(hash), blk.Head.Number)
+	}
+	rdb := newRWSetDB(db)
+	isolator := &vm.Isolator{}
+	isolator.TriggerReplayMode()
+	if idx == 0 {
+		vi := database.NewVisitor(100, rdb, blk.Head.Rules())
+		isolator.Prepare(blk.Head, vi, getLogger(false))
+		isolator.TriggerBlockBaseMode()
+		return traceTx(isolator, blk.Txs[0], c.Timeout)
+	}
+	if _, err := blockBaseExec(blk, rdb, isolator, blk.Txs[0], c); err != nil {
+		return nil, nil, err
+	}
+	if _, err := settleDeferTxs(blk, rdb); err != nil {
+		return nil, nil, err
+	}
+	for k := 1; k < idx; k++ {
+		if err := verify(*isolator, blk.Txs[k], blk.Receipts[k], c.TxTimeLimit, false, blk, nil); err != nil {
+			return nil, nil, fmt.Errorf("replay tx %v failed: %v", common.Base58Encode(blk.Txs[k].Hash()), err)
+		}
+	}
+	isolator.ClearTx()
+	limit := c.TxTimeLimit * 50
+	if blk.Receipts[idx].Status.Code == tx.ErrorTimeout {
+		limit = 0
+	}
+	return traceTx(isolator, blk.Txs[idx], limit)
+}
+
+func traceTx(isolator *vm.Isolator, t *tx.Tx, limit time.Duration) (*tx.TxReceipt, []*host.CallFrame, error) {
+	tracer := host.NewTracer()
+	isolator.SetTracer(tracer)
+	defer isolator.SetTracer(nil)
+	err := isolator.PrepareTx(t, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	_, err = isolator.Run()
+	if err != nil {
+		return nil, nil, err
+	}
+	r, err := isolator.PayCost()
+	if err != nil {
+		return nil, nil, err
+	}
+	return r, tracer.Frames(), nil
 }
 
 // Gen gen block
 func (v *Executor) Gen(blk, parent *block.Block, witnessList *blockcache.WitnessList, db database.IMultiValue, iter *txpool.SortedTxMap, c *Config) (droplist []*tx.Tx, errs []error, err error) {
 	isolator := &vm.Isolator{}
+	limits := host.ReadBlockLimits(db, blk.Head.Rules())
 	baseTx, err := NewBaseTx(blk, parent, witnessList)
 	if err != nil {
 		return nil, nil, err
@@ -76,16 +173,97 @@ func (v *Executor) Gen(blk, parent *block.Block, witnessList *blockcache.Witness
 	}
 	blk.Txs = append(blk.Txs, baseTx)
 	blk.Receipts = append(blk.Receipts, r)
+	deferTxs, err := settleDeferTxs(blk, db)
+	if err != nil {
+		return nil, nil, err
+	}
+	// due deferred txs are executed before txs in pool
+	err = genDeferTxs(blk, isolator, deferTxs, c, limits)
+	if err != nil {
+		return nil, nil, err
+	}
 	var pi = NewProvider(iter)
-	err = baseGen(blk, db, pi, isolator, c)
+	err = baseGen(blk, db, pi, isolator, c, limits)
 	droplist, errs = pi.List()
 	pi.Close()
 	return
 }
 
+// settleDeferTxs purges the expired delay txs from schedule, and returns the deferred txs of the due ones.
+// It's done in every block after the block base tx, so the schedule is the same on all nodes and the
+// missed delay txs aren't left forever.
+func settleDeferTxs(blk *block.Block, db database.IMultiValue) ([]*tx.Tx, error) {
+	if !blk.Head.Rules().IsFork3_11_0 {
+		return nil, nil
+	}
+	rdb, ok := db.(database.IRangeValue)
+	if !ok {
+		return nil, errNoRangeQuery
+	}
+	encoded, err := database.DueDelaytxs(rdb, blk.Head.Time, maxDeferTxsInBlock)
+	if err != nil {
+		return nil, err
+	}
+	txs := make([]*tx.Tx, 0, len(encoded))
+	for _, e := range encoded {
+		t := &tx.Tx{}
+		if err := t.Decode([]byte(e)); err != nil {
+			return nil, err
+		}
+		d := t.DeferTx()
+		if d.IsExpired(blk.Head.Time) {
+			if err := database.RemoveDelaytx(db, common.Base58Encode(t.Hash())); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		txs = append(txs, d)
+	}
+	return txs, nil
+}
+
+// prepareDeferTx checks whether the due deferred tx can be executed after the receipts of block. The producer can
+// skip the deferred tx only if it can't, which is checked by verifiers in the same way.
+func prepareDeferTx(isolator *vm.Isolator, t *tx.Tx, limit time.Duration, receipts []*tx.TxReceipt, limits *host.BlockLimits) error {
+	isolator.ClearTx()
+	if len(receipts) >= limits.TxLimit {
+		return fmt.Errorf("block tx limit %v reached", limits.TxLimit)
+	}
+	if t.GasLimit > limits.GasLimit-blockGasUsed(receipts[1:]) {
+		return fmt.Errorf("gas limit %v exceeds the remaining block gas", t.GasLimit)
+	}
+	return isolator.PrepareTx(t, limit)
+}
+
+// genDeferTxs executes the due deferred txs right after the block base tx.
+func genDeferTxs(blk *block.Block, isolator *vm.Isolator, txs []*tx.Tx, c *Config, limits *host.BlockLimits) error {
+	for _, t := range txs {
+		if err := prepareDeferTx(isolator, t, c.TxTimeLimit, blk.Receipts, limits); err != nil {
+			ilog.Debugf("Skip deferred tx %v: %v", common.Base58Encode(t.Hash()), err)
+			continue
+		}
+		_, err := isolator.Run()
+		if err != nil {
+			return err
+		}
+		r, err := isolator.PayCost()
+		if err != nil {
+			return err
+		}
+		if c.StateDiffs != nil {
+			c.StateDiffs[string(t.Hash())] = isolator.StateDiff()
+		}
+		isolator.Commit()
+		blk.Txs = append(blk.Txs, t)
+		blk.Receipts = append(blk.Receipts, r)
+	}
+	isolator.ClearTx()
+	return nil
+}
+
 func blockBaseExec(blk *block.Block, db database.IMultiValue, isolator *vm.Isolator, t *tx.Tx, c *Config) (tr *tx.TxReceipt, err error) {
 	vi := database.NewVisitor(100, db, blk.Head.Rules())
-	isolator.Prepare(blk.Head, vi, getLogger(global.GetGlobalConf() != nil && global.GetGlobalConf().Log != nil && global.GetGlobalConf().Log.EnableContractLog))
+	isolator.Prepare(blk.Head, vi, getLogger(contractLogEnabled()))
 	isolator.TriggerBlockBaseMode()
 	err = isolator.PrepareTx(t, c.Timeout)
 	if err != nil {
@@ -98,6 +276,9 @@ func blockBaseExec(blk *block.Block, db database.IMultiValue, isolator *vm.Isola
 	if r.Status.Code != tx.Success {
 		return nil, fmt.Errorf(r.Status.Message)
 	}
+	if c.StateDiffs != nil {
+		c.StateDiffs[string(t.Hash())] = isolator.StateDiff()
+	}
 	isolator.Commit()
 	isolator.ClearTx()
 
@@ -105,10 +286,14 @@ func blockBaseExec(blk *block.Block, db database.IMultiValue, isolator *vm.Isola
 }
 
 // nolint:gocyclo
-func baseGen(blk *block.Block, db database.IMultiValue, provider Provider, isolator *vm.Isolator, c *Config) (err error) {
-	blockTxLimit := 50
-	blockGasLimit := common.MaxBlockGasLimit
-	txLimitSameSender := 10
+func baseGen(blk *block.Block, db database.IMultiValue, provider Provider, isolator *vm.Isolator, c *Config, limits *host.BlockLimits) (err error) {
+	if c.Thread > 1 {
+		err = parallelGen(blk, db, provider, c, limits)
+		finishGen(blk, provider)
+		return err
+	}
+	blockTxLimit := c.blockTxLimit(limits)
+	blockGasLimit := limits.GasLimit - blockGasUsed(blk.Receipts[1:])
 	var tn time.Time
 	to := time.Now().Add(c.Timeout)
 
@@ -128,42 +313,9 @@ L:
 		if t == nil {
 			break L
 		}
-		if !t.IsCreatedBefore(blk.Head.Time) {
-			ilog.Debugf(
-				"Tx %v has not arrived. tx time is %v, blk time is %v",
-				common.Base58Encode(t.Hash()),
-				t.Time,
-				blk.Head.Time,
-			)
-			continue L
-		}
-		if t.Delay != 0 {
-			ilog.Debug("Ignore delay tx.")
-			continue L
-		}
-		if tx.CheckBadTx(t) != nil {
-			ilog.Errorf("bad tx %v", t)
-			continue L
-		}
-		if t.IsExpired(blk.Head.Time) {
-			ilog.Errorf(
-				"Tx %v is expired, tx time is %v, tx expiration time is %v, blk time is %v",
-				common.Base58Encode(t.Hash()),
-				t.Time,
-				t.Expiration,
-				blk.Head.Time,
-			)
-			provider.Drop(t, ErrExpiredTx)
-			continue L
-		}
-		if t.GasLimit > blockGasLimit {
+		if !checkGenTx(blk, t, provider, blockGasLimit, senderCount, limits.TxLimitSameSender) {
 			continue L
 		}
-		thisSenderCount := senderCount[t.Publisher]
-		if thisSenderCount >= txLimitSameSender {
-			continue L
-		}
-		senderCount[t.Publisher] = thisSenderCount + 1
 		err := isolator.PrepareTx(t, limit)
 		if err != nil {
 			ilog.Errorf("PrepareTx failed. tx %v limit %v err %v", t.String(), limit, err)
@@ -193,6 +345,9 @@ L:
 			provider.Drop(t, err)
 			continue L
 		}
+		if c.StateDiffs != nil {
+			c.StateDiffs[string(t.Hash())] = isolator.StateDiff()
+		}
 		isolator.Commit()
 		blk.Txs = append(blk.Txs, t)
 		blk.Receipts = append(blk.Receipts, r)
@@ -201,11 +356,60 @@ L:
 		}
 		blockGasLimit -= r.GasUsage
 	}
+	finishGen(blk, provider)
+	return err
+}
+
+// checkGenTx checks whether the tx can be executed in the generating block, the sender count is increased if it can.
+func checkGenTx(blk *block.Block, t *tx.Tx, provider Provider, blockGasLimit int64, senderCount map[string]int, txLimitSameSender int) bool {
+	if !t.IsCreatedBefore(blk.Head.Time) {
+		ilog.Debugf(
+			"Tx %v has not arrived. tx time is %v, blk time is %v",
+			common.Base58Encode(t.Hash()),
+			t.Time,
+			blk.Head.Time,
+		)
+		return false
+	}
+	if t.Delay != 0 && !blk.Head.Rules().IsFork3_11_0 {
+		ilog.Debug("Ignore delay tx.")
+		return false
+	}
+	if tx.CheckBadTx(t) != nil {
+		ilog.Errorf("bad tx %v", t)
+		return false
+	}
+	if t.IsExpired(blk.Head.Time) {
+		ilog.Errorf(
+			"Tx %v is expired, tx time is %v, tx expiration time is %v, blk time is %v",
+			common.Base58Encode(t.Hash()),
+			t.Time,
+			t.Expiration,
+			blk.Head.Time,
+		)
+		provider.Drop(t, ErrExpiredTx)
+		return false
+	}
+	if t.GasLimit > blockGasLimit {
+		return false
+	}
+	thisSenderCount := senderCount[t.Publisher]
+	if thisSenderCount >= txLimitSameSender {
+		return false
+	}
+	senderCount[t.Publisher] = thisSenderCount + 1
+	return true
+}
+
+func finishGen(blk *block.Block, provider Provider) {
 	blk.Head.Info = []byte(`{}`) // for legacy reasons. remove it in later version
 	for _, t := range blk.Txs {
 		provider.Drop(t, nil)
 	}
-	return err
+}
+
+func contractLogEnabled() bool {
+	return global.GetGlobalConf() != nil && global.GetGlobalConf().Log != nil && global.GetGlobalConf().Log.EnableContractLog
 }
 
 func getLogger(enableContractLog bool) *ilog.Logger {
@@ -0,0 +1,374 @@
+package verifier
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/core/tx"
+	"github.com/iost-official/go-iost/v3/ilog"
+	"github.com/iost-official/go-iost/v3/vm"
+	"github.com/iost-official/go-iost/v3/vm/database"
+	"github.com/iost-official/go-iost/v3/vm/host"
+)
+
+// Txs are executed optimistically in parallel: every tx runs on its own rwSetDB over the state
+// before the batch, then they are committed in order. A tx is executed again if any value it read
+// has been changed by the txs committed before it, so the result is the same as serial execution.
+
+type dbKey struct {
+	table string
+	key   string
+}
+
+type dbWrite struct {
+	value   string
+	deleted bool
+}
+
+// rwSetDB is the database of a tx executed in parallel. It reads from base without changing it,
+// records the values read and buffers the writes.
+type rwSetDB struct {
+	base   database.IMultiValue
+	reads  map[dbKey]string
+	exists map[dbKey]bool
+	writes map[dbKey]*dbWrite
+	order  []dbKey
+}
+
+func newRWSetDB(base database.IMultiValue) *rwSetDB {
+	return &rwSetDB{
+		base:   base,
+		reads:  make(map[dbKey]string),
+		exists: make(map[dbKey]bool),
+		writes: make(map[dbKey]*dbWrite),
+	}
+}
+
+// Get returns the value written by the tx, or the value in base.
+func (d *rwSetDB) Get(table string, key string) (string, error) {
+	k := dbKey{table, key}
+	if w, ok := d.writes[k]; ok {
+		if w.deleted {
+			return "", nil
+		}
+		return w.value, nil
+	}
+	if v, ok := d.reads[k]; ok {
+		return v, nil
+	}
+	v, err := d.base.Get(table, key)
+	if err != nil {
+		return "", err
+	}
+	d.reads[k] = v
+	return v, nil
+}
+
+// Has returns whether the key exists after the writes of the tx.
+func (d *rwSetDB) Has(table string, key string) (bool, error) {
+	k := dbKey{table, key}
+	if w, ok := d.writes[k]; ok {
+		return !w.deleted, nil
+	}
+	if e, ok := d.exists[k]; ok {
+		return e, nil
+	}
+	e, err := d.base.Has(table, key)
+	if err != nil {
+		return false, err
+	}
+	d.exists[k] = e
+	return e, nil
+}
+
+// Put buffers the value.
+func (d *rwSetDB) Put(table string, key string, value string) error {
+	d.write(dbKey{table, key}, &dbWrite{value: value})
+	return nil
+}
+
+// Del buffers the deletion.
+func (d *rwSetDB) Del(table string, key string) error {
+	d.write(dbKey{table, key}, &dbWrite{deleted: true})
+	return nil
+}
+
+func (d *rwSetDB) write(k dbKey, w *dbWrite) {
+	if _, ok := d.writes[k]; !ok {
+		d.order = append(d.order, k)
+	}
+	d.writes[k] = w
+}
+
+// KeysByRange returns the keys in [from, to) of the table after the writes of the tx. The keys aren't
+// recorded as read, so it's only used on the db of a block, such as the replay of TraceInBlock.
+func (d *rwSetDB) KeysByRange(table string, from string, to string, limit int) ([]string, error) {
+	rdb, ok := d.base.(database.IRangeValue)
+	if !ok {
+		return nil, errNoRangeQuery
+	}
+	written := make(map[string]bool)
+	storageLimit := limit
+	for k, w := range d.writes {
+		if k.table != table || k.key < from || k.key >= to {
+			continue
+		}
+		written[k.key] = !w.deleted
+		if w.deleted && limit > 0 {
+			storageLimit++
+		}
+	}
+	keys, err := rdb.KeysByRange(table, from, to, storageLimit)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]string, 0, len(keys)+len(written))
+	for _, k := range keys {
+		if _, ok := written[k]; !ok {
+			results = append(results, k)
+		}
+	}
+	for k, exist := range written {
+		if exist {
+			results = append(results, k)
+		}
+	}
+	sort.Strings(results)
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+// valid checks whether the values read are the same in db.
+func (d *rwSetDB) valid(db database.IMultiValue) (bool, error) {
+	for k, v := range d.reads {
+		cur, err := db.Get(k.table, k.key)
+		if err != nil {
+			return false, err
+		}
+		if cur != v {
+			return false, nil
+		}
+	}
+	for k, e := range d.exists {
+		cur, err := db.Has(k.table, k.key)
+		if err != nil {
+			return false, err
+		}
+		if cur != e {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// apply writes the buffered changes to db in the order of the first write of keys.
+func (d *rwSetDB) apply(db database.IMultiValue) error {
+	for _, k := range d.order {
+		w := d.writes[k]
+		var err error
+		if w.deleted {
+			err = db.Del(k.table, k.key)
+		} else {
+			err = db.Put(k.table, k.key, w.value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type execOptions struct {
+	contractLog bool
+	stateDiff   bool
+}
+
+// txResult is the result of a tx executed on rwSetDB.
+type txResult struct {
+	db        *rwSetDB
+	receipt   *tx.TxReceipt
+	stateDiff []*tx.StateChange
+	// events are posted only if the result is committed in order
+	events host.KeptEvents
+	err    error
+}
+
+// execTx runs the tx on a new rwSetDB over db, without changing db.
+func execTx(blk *block.Block, db database.IMultiValue, t *tx.Tx, limit time.Duration, opts execOptions) *txResult {
+	res := &txResult{db: newRWSetDB(db)}
+	isolator := &vm.Isolator{}
+	vi := database.NewVisitor(100, res.db, blk.Head.Rules())
+	isolator.Prepare(blk.Head, vi, getLogger(opts.contractLog))
+	if res.err = isolator.PrepareTx(t, limit); res.err != nil {
+		return res
+	}
+	if _, res.err = isolator.Run(); res.err != nil {
+		return res
+	}
+	if res.receipt, res.err = isolator.PayCost(); res.err != nil {
+		return res
+	}
+	if opts.stateDiff {
+		res.stateDiff = isolator.StateDiff()
+	}
+	res.events = isolator.TakeEvents()
+	isolator.Commit()
+	return res
+}
+
+// execParallel runs txs on db by thread goroutines, the results are in the order of txs.
+func execParallel(blk *block.Block, db database.IMultiValue, txs []*tx.Tx, limit func(k int) time.Duration, thread int, opts execOptions) []*txResult {
+	results := make([]*txResult, len(txs))
+	jobs := make(chan int, len(txs))
+	for k := range txs {
+		jobs <- k
+	}
+	close(jobs)
+	var wg sync.WaitGroup
+	for n := 0; n < thread && n < len(txs); n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := range jobs {
+				results[k] = execTx(blk, db, txs[k], limit(k), opts)
+			}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// revalidate returns the result of tx on the current db. The tx is executed again if the values it read are changed,
+// or it timed out, since the timeout in parallel can be caused by the contention of other txs, and it's decided by the
+// serial execution on all nodes whatever the thread is.
+func revalidate(blk *block.Block, db database.IMultiValue, t *tx.Tx, res *txResult, limit time.Duration, opts execOptions) (*txResult, error) {
+	ok, err := res.db.valid(db)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || (res.err == nil && res.receipt.Status.Code == tx.ErrorTimeout) {
+		res = execTx(blk, db, t, limit, opts)
+	}
+	return res, nil
+}
+
+// parallelVerify verifies txs and receipts of the block by executing txs in parallel.
+func parallelVerify(c *Config, txs []*tx.Tx, receipts []*tx.TxReceipt, blk *block.Block, db database.IMultiValue) error {
+	for _, t := range txs {
+		if !t.IsCreatedBefore(blk.Head.Time) {
+			return ErrNotArrivedTx
+		}
+		if t.IsExpired(blk.Head.Time) {
+			return ErrExpiredTx
+		}
+	}
+	limit := func(k int) time.Duration {
+		if receipts[k].Status.Code == tx.ErrorTimeout {
+			return 0
+		}
+		return c.TxTimeLimit * 50
+	}
+	opts := execOptions{stateDiff: c.StateDiffs != nil}
+	results := execParallel(blk, db, txs, limit, c.Thread, opts)
+	for k, t := range txs {
+		res, err := revalidate(blk, db, t, results[k], limit(k), opts)
+		if err != nil {
+			return err
+		}
+		if res.err != nil {
+			return res.err
+		}
+		if err := checkReceiptEqual(receipts[k], res.receipt); err != nil {
+			return err
+		}
+		if err := res.db.apply(db); err != nil {
+			return err
+		}
+		res.events.Post()
+		if c.StateDiffs != nil {
+			c.StateDiffs[string(t.Hash())] = res.stateDiff
+		}
+	}
+	return nil
+}
+
+// parallelGen packs txs from provider into the block, executing them in batches of c.Thread txs in parallel.
+func parallelGen(blk *block.Block, db database.IMultiValue, provider Provider, c *Config, limits *host.BlockLimits) error {
+	blockTxLimit := c.blockTxLimit(limits)
+	blockGasLimit := limits.GasLimit - blockGasUsed(blk.Receipts[1:])
+	to := time.Now().Add(c.Timeout)
+	opts := execOptions{contractLog: contractLogEnabled(), stateDiff: c.StateDiffs != nil}
+
+	senderCount := make(map[string]int)
+	for len(blk.Txs) < blockTxLimit {
+		limit := time.Until(to)
+		if limit > c.TxTimeLimit {
+			limit = c.TxTimeLimit
+		}
+		if limit < 500*time.Microsecond {
+			return nil
+		}
+		batch := make([]*tx.Tx, 0, c.Thread)
+		for len(batch) < c.Thread && len(blk.Txs)+len(batch) < blockTxLimit {
+			t := provider.Tx()
+			if t == nil {
+				break
+			}
+			if checkGenTx(blk, t, provider, blockGasLimit, senderCount, limits.TxLimitSameSender) {
+				batch = append(batch, t)
+			}
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+		results := execParallel(blk, db, batch, func(int) time.Duration { return limit }, c.Thread, opts)
+		for k, t := range batch {
+			res, err := revalidate(blk, db, t, results[k], limit, opts)
+			if err != nil {
+				returnTxs(provider, batch[k:])
+				return err
+			}
+			if res.err != nil {
+				ilog.Errorf("exec tx failed. tx %v limit %v err %v", t.String(), limit, res.err)
+				provider.Drop(t, res.err)
+				continue
+			}
+			if res.receipt.Status.Code == tx.ErrorTimeout && limit < c.TxTimeLimit {
+				ilog.Debugf(
+					"isolator run time out, but time limit %v less than std time limit %v",
+					limit,
+					c.TxTimeLimit,
+				)
+				returnTxs(provider, batch[k:])
+				return nil
+			}
+			if t.GasLimit > blockGasLimit {
+				provider.Return(t)
+				continue
+			}
+			if err := res.db.apply(db); err != nil {
+				returnTxs(provider, batch[k:])
+				return err
+			}
+			res.events.Post()
+			blk.Txs = append(blk.Txs, t)
+			blk.Receipts = append(blk.Receipts, res.receipt)
+			if c.StateDiffs != nil {
+				c.StateDiffs[string(t.Hash())] = res.stateDiff
+			}
+			blockGasLimit -= res.receipt.GasUsage
+		}
+	}
+	return nil
+}
+
+// returnTxs returns txs to provider, so that they are provided again in the same order.
+func returnTxs(provider Provider, txs []*tx.Tx) {
+	for k := len(txs) - 1; k >= 0; k-- {
+		provider.Return(txs[k])
+	}
+}
@@ -0,0 +1,152 @@
+package verifier
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/iost-official/go-iost/v3/account"
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/core/tx"
+	"github.com/iost-official/go-iost/v3/core/txpool"
+	"github.com/iost-official/go-iost/v3/crypto"
+	"github.com/iost-official/go-iost/v3/vm"
+	"github.com/iost-official/go-iost/v3/vm/database"
+	"github.com/iost-official/go-iost/v3/vm/host"
+	"github.com/iost-official/go-iost/v3/vm/native"
+	"github.com/stretchr/testify/assert"
+)
+
+type mapDB map[string]string
+
+func (m mapDB) Get(table string, key string) (string, error) {
+	return m[table+"/"+key], nil
+}
+
+func (m mapDB) Put(table string, key string, value string) error {
+	m[table+"/"+key] = value
+	return nil
+}
+
+func (m mapDB) Del(table string, key string) error {
+	delete(m, table+"/"+key)
+	return nil
+}
+
+func (m mapDB) Has(table string, key string) (bool, error) {
+	_, ok := m[table+"/"+key]
+	return ok, nil
+}
+
+func TestRWSetDB(t *testing.T) {
+	base := mapDB{"state/a": "1", "state/b": "2"}
+
+	d := newRWSetDB(base)
+	v, _ := d.Get("state", "a")
+	assert.Equal(t, "1", v)
+	ok, _ := d.Has("state", "c")
+	assert.False(t, ok)
+	d.Put("state", "b", "3")
+	d.Del("state", "a")
+	v, _ = d.Get("state", "b")
+	assert.Equal(t, "3", v)
+	ok, _ = d.Has("state", "a")
+	assert.False(t, ok)
+	assert.Equal(t, "2", base["state/b"], "base should not be changed before apply")
+
+	ok, _ = d.valid(base)
+	assert.True(t, ok)
+	assert.NoError(t, d.apply(base))
+	assert.Equal(t, mapDB{"state/b": "3"}, base)
+
+	d = newRWSetDB(base)
+	d.Get("state", "b")
+	base.Put("state", "b", "4")
+	ok, _ = d.valid(base)
+	assert.False(t, ok, "changed read should be invalid")
+
+	d = newRWSetDB(base)
+	d.Has("state", "c")
+	base.Put("state", "c", "5")
+	ok, _ = d.valid(base)
+	assert.False(t, ok, "created key should be invalid")
+}
+
+func TestParallelExecution(t *testing.T) {
+	s := NewSimulator()
+	defer s.Clear()
+	s.SetContract(native.SystemABI())
+	kps := make(map[string]*account.KeyPair)
+	for k := 0; k < 3; k++ {
+		id := fmt.Sprintf("user_%d", k)
+		kp, err := account.NewKeyPair(nil, crypto.Secp256k1)
+		assert.NoError(t, err)
+		s.SetAccount(account.NewAccountFromKeys(id, kp.ReadablePubkey(), kp.ReadablePubkey()))
+		s.SetGas(id, 1e8)
+		kps[id] = kp
+	}
+	s.Visitor.Commit()
+	s.Mvcc.Commit("setup")
+
+	// txs of the same publisher conflict on gas, so some of them are executed again in parallel
+	txs := make([]*tx.Tx, 0)
+	for k := 0; k < 12; k++ {
+		id := fmt.Sprintf("user_%d", k%3)
+		trx := tx.NewTx([]*tx.Action{{
+			Contract:   "system.iost",
+			ActionName: "receipt",
+			Data:       fmt.Sprintf(`["%v"]`, k),
+		}}, nil, 1000000, 100, s.Head.Time+int64(time.Minute), 0, 0)
+		trx.Time = s.Head.Time - int64(k)
+		stx, err := tx.SignTx(trx, id, []*account.KeyPair{kps[id]})
+		assert.NoError(t, err)
+		txs = append(txs, stx)
+	}
+
+	newIsolator := func(blk *block.Block, db database.IMultiValue) *vm.Isolator {
+		isolator := &vm.Isolator{}
+		isolator.Prepare(blk.Head, database.NewVisitor(100, db, blk.Head.Rules()), getLogger(false))
+		return isolator
+	}
+	gen := func(thread int) *block.Block {
+		db := s.Mvcc.Fork()
+		// the first tx and receipt are the placeholder of block base tx
+		blk := &block.Block{Head: s.Head, Txs: []*tx.Tx{{}}, Receipts: []*tx.TxReceipt{{}}}
+		pool := txpool.NewSortedTxMap()
+		for _, trx := range txs {
+			pool.Add(trx)
+		}
+		c := &Config{Timeout: 10 * time.Second, TxTimeLimit: time.Second, Thread: thread}
+		err := baseGen(blk, db, NewProvider(pool), newIsolator(blk, db), c, host.ReadBlockLimits(db, blk.Head.Rules()))
+		assert.NoError(t, err)
+		return blk
+	}
+
+	serial := gen(1)
+	parallel := gen(4)
+	assert.Equal(t, len(txs)+1, len(serial.Txs))
+	assert.Equal(t, len(serial.Txs), len(parallel.Txs))
+	for k := 1; k < len(serial.Txs); k++ {
+		assert.Equal(t, serial.Txs[k].Hash(), parallel.Txs[k].Hash())
+		assert.NoError(t, checkReceiptEqual(serial.Receipts[k], parallel.Receipts[k]))
+	}
+
+	c := &Config{Timeout: 10 * time.Second, TxTimeLimit: time.Second, Thread: 4}
+	for _, blk := range []*block.Block{serial, parallel} {
+		db := s.Mvcc.Fork()
+		assert.NoError(t, baseVerify(*newIsolator(blk, db), c, blk.Txs[1:], blk.Receipts[1:], blk))
+		db = s.Mvcc.Fork()
+		assert.NoError(t, parallelVerify(c, blk.Txs[1:], blk.Receipts[1:], blk, db))
+	}
+
+	// the empty receipts are filled in verification
+	receipts := make([]*tx.TxReceipt, len(serial.Txs)-1)
+	for k := range receipts {
+		receipts[k] = &tx.TxReceipt{}
+	}
+	db := s.Mvcc.Fork()
+	assert.NoError(t, baseVerify(*newIsolator(serial, db), c, serial.Txs[1:], receipts, serial))
+	for k, r := range receipts {
+		assert.Equal(t, serial.Receipts[k+1].Hash(), r.Hash())
+	}
+}
@@ -1,6 +1,7 @@
 package verifier
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/iost-official/go-iost/v3/ilog"
 	"github.com/iost-official/go-iost/v3/vm"
 	"github.com/iost-official/go-iost/v3/vm/database"
+	"github.com/iost-official/go-iost/v3/vm/host"
 )
 
 // values
@@ -19,6 +21,13 @@ var (
 	ErrExpiredTx    = errors.New("expired tx")
 	ErrNotArrivedTx = errors.New("not arrived tx")
 	ErrInvalidMode  = errors.New("invalid mode")
+
+	ErrReceiptMerkleHash = errors.New("receipt merkle hash not match")
+	ErrReceiptMismatch   = errors.New("receipt not match")
+
+	// errTxTimeout means the receipt differs from the block since the tx timed out locally, which doesn't prove
+	// the block is invalid.
+	errTxTimeout = errors.New("tx timed out in verification")
 )
 
 // Verifier ..
@@ -30,19 +39,108 @@ type Config struct {
 	Mode        int
 	Timeout     time.Duration
 	TxTimeLimit time.Duration
-	Thread      int
+	// Thread is the number of txs executed in parallel, txs are executed serially if it is less than 2.
+	Thread int
+	// BlockTxLimit is the max number of txs in a generated block, it can only be lower than the limit on chain.
+	BlockTxLimit int
+	// StateDiffs collects the state changes of txs by tx hash if it isn't nil. They are recorded by the node
+	// optionally, and are kept out of blocks.
+	StateDiffs map[string][]*tx.StateChange
+}
+
+func (c *Config) blockTxLimit(limits *host.BlockLimits) int {
+	if c.BlockTxLimit > 0 && c.BlockTxLimit < limits.TxLimit {
+		return c.BlockTxLimit
+	}
+	return limits.TxLimit
 }
 
-// Verify verify block generated by Verifier
+// Verify verify block generated by Verifier.
+// The receipts of the block are computed if they are nil, such as the block reconstructed from a compact block,
+// then they are checked by the receipt merkle hash in head instead.
 func (v *Verifier) Verify(blk, parent *block.Block, witnessList *blockcache.WitnessList, db database.IMultiValue, c *Config) error {
+	if blk.Receipts != nil {
+		return verifyBlock(blk, parent, witnessList, db, c)
+	}
+	// the receipts are filled in place of the empty ones in execution
+	blk.Receipts = make([]*tx.TxReceipt, len(blk.Txs))
+	for i := range blk.Receipts {
+		blk.Receipts[i] = &tx.TxReceipt{}
+	}
+	// txs are executed serially, since the parallel execution relies on the timeout status in receipts
+	serial := *c
+	serial.Thread = 0
+	err := verifyBlock(blk, parent, witnessList, db, &serial)
+	if err == nil {
+		err = checkBlockGas(blk, host.ReadBlockLimits(db, blk.Head.Rules()))
+	}
+	if err == nil && !bytes.Equal(blk.CalculateTxReceiptMerkleHash(), blk.Head.TxReceiptMerkleHash) {
+		err = ErrReceiptMerkleHash
+		for _, r := range blk.Receipts {
+			if r.Status.Code == tx.ErrorTimeout {
+				err = errTxTimeout
+				break
+			}
+		}
+	}
+	if err != nil {
+		blk.Receipts = nil
+	}
+	return err
+}
+
+func verifyBlock(blk, parent *block.Block, witnessList *blockcache.WitnessList, db database.IMultiValue, c *Config) error {
+	limits := host.ReadBlockLimits(db, blk.Head.Rules())
 	err := verifyBlockBase(blk, parent, witnessList, db, c)
 	if err != nil {
 		return err
 	}
+	if err := checkBlockGas(blk, limits); err != nil {
+		return err
+	}
+	if err := checkBlockTxs(blk, limits); err != nil {
+		return err
+	}
+	deferTxs, err := settleDeferTxs(blk, db)
+	if err != nil {
+		return err
+	}
 	isolator := vm.Isolator{}
 	vi := database.NewBatchVisitor(database.NewBatchVisitorRoot(100, db, blk.Head.Rules()))
 	isolator.Prepare(blk.Head, vi, getLogger(false))
-	return baseVerify(isolator, c, blk.Txs[1:], blk.Receipts[1:], blk)
+	k, err := verifyDeferTxs(&isolator, c, deferTxs, blk, limits)
+	if err != nil {
+		return err
+	}
+	for _, t := range blk.Txs[k:] {
+		if t.IsDefer() {
+			return errDeferTxOrder
+		}
+	}
+	if c.Thread > 1 {
+		return parallelVerify(c, blk.Txs[k:], blk.Receipts[k:], blk, db)
+	}
+	return baseVerify(isolator, c, blk.Txs[k:], blk.Receipts[k:], blk)
+}
+
+// verifyDeferTxs verifies the due deferred txs following the block base tx, and returns the index of
+// the first tx after them. A due deferred tx can be absent only if it can't be executed in the block.
+func verifyDeferTxs(isolator *vm.Isolator, c *Config, txs []*tx.Tx, blk *block.Block, limits *host.BlockLimits) (int, error) {
+	k := 1
+	for _, t := range txs {
+		if k < len(blk.Txs) && bytes.Equal(blk.Txs[k].Hash(), t.Hash()) {
+			if err := verify(*isolator, blk.Txs[k], blk.Receipts[k], c.TxTimeLimit, false, blk, c.StateDiffs); err != nil {
+				return 0, err
+			}
+			k++
+			continue
+		}
+		if err := prepareDeferTx(isolator, t, 0, blk.Receipts[:k], limits); err == nil {
+			return 0, fmt.Errorf("%v: %v", errMissedDeferTx, common.Base58Encode(t.Hash()))
+		}
+	}
+	isolator.ClearTx()
+	return k, nil
 }
 func verifyBlockBase(blk, parent *block.Block, witnessList *blockcache.WitnessList, db database.IMultiValue, c *Config) error {
 	if len(blk.Txs) < 1 || len(blk.Receipts) < 1 {
@@ -68,15 +166,10 @@ func verifyBlockBase(blk, parent *block.Block, witnessList *blockcache.WitnessLi
 	if r.Status.Code != tx.Success {
 		return fmt.Errorf("block base tx receipt error: %v", r.Status.Message)
 	}
-	err = checkReceiptEqual(blk.Receipts[0], r)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return matchReceipt(blk.Receipts[0], r)
 }
 
-func verify(isolator vm.Isolator, t *tx.Tx, r *tx.TxReceipt, timeout time.Duration, isBlockBase bool, blk *block.Block) error { // nolint
+func verify(isolator vm.Isolator, t *tx.Tx, r *tx.TxReceipt, timeout time.Duration, isBlockBase bool, blk *block.Block, stateDiffs map[string][]*tx.StateChange) error { // nolint
 	if !t.IsCreatedBefore(blk.Head.Time) {
 		return ErrNotArrivedTx
 	}
@@ -88,7 +181,7 @@ func verify(isolator vm.Isolator, t *tx.Tx, r *tx.TxReceipt, timeout time.Durati
 		isolator.TriggerBlockBaseMode()
 	}
 	var to time.Duration
-	if r.Status.Code == tx.ErrorTimeout {
+	if r.Status != nil && r.Status.Code == tx.ErrorTimeout {
 		to = 0
 	} else {
 		to = timeout * 50
@@ -105,73 +198,119 @@ func verify(isolator vm.Isolator, t *tx.Tx, r *tx.TxReceipt, timeout time.Durati
 	if err != nil {
 		return err
 	}
-	err = checkReceiptEqual(r, receipt)
+	err = matchReceipt(r, receipt)
 	if err != nil {
 		return err
 	}
+	if stateDiffs != nil {
+		stateDiffs[string(t.Hash())] = isolator.StateDiff()
+	}
 	isolator.Commit()
 	return nil
 }
 
+// matchReceipt checks the receipt of execution with r in block, or fills r with it if r is empty.
+func matchReceipt(r *tx.TxReceipt, receipt *tx.TxReceipt) error {
+	if r.Status == nil {
+		*r = *receipt
+		return nil
+	}
+	return checkReceiptEqual(r, receipt)
+}
+
 func checkReceiptEqual(r *tx.TxReceipt, receipt *tx.TxReceipt) error {
+	if r.Status.Code != receipt.Status.Code && receipt.Status.Code == tx.ErrorTimeout {
+		return fmt.Errorf("%w: %v", errTxTimeout, common.Base58Encode(r.TxHash))
+	}
 	if r.Status.Code != receipt.Status.Code {
-		return fmt.Errorf("receipt not match, status not same: %v != %v \n%v\n%v", r.Status, receipt.Status, r, receipt)
+		return fmt.Errorf("%w, status not same: %v != %v \n%v\n%v", ErrReceiptMismatch, r.Status, receipt.Status, r, receipt)
 	}
 	if r.Status.Code == tx.Success {
 		if r.Status.Message != receipt.Status.Message {
-			return fmt.Errorf("receipt not match, status not same: %v != %v \n%v\n%v", r.Status, receipt.Status, r, receipt)
+			return fmt.Errorf("%w, status not same: %v != %v \n%v\n%v", ErrReceiptMismatch, r.Status, receipt.Status, r, receipt)
 		}
 	}
 	if r.GasUsage != receipt.GasUsage {
-		return fmt.Errorf("receipt not match, gas usage not same: %v != %v \n%v\n%v", r.GasUsage, receipt.GasUsage, r, receipt)
+		return fmt.Errorf("%w, gas usage not same: %v != %v \n%v\n%v", ErrReceiptMismatch, r.GasUsage, receipt.GasUsage, r, receipt)
 	}
 	if len(r.RAMUsage) != len(receipt.RAMUsage) {
-		return fmt.Errorf("receipt not match, ram usage length not same: %v != %v \n%v\n%v", len(r.RAMUsage), len(receipt.RAMUsage), r, receipt)
+		return fmt.Errorf("%w, ram usage length not same: %v != %v \n%v\n%v", ErrReceiptMismatch, len(r.RAMUsage), len(receipt.RAMUsage), r, receipt)
 	}
 	for k, v := range r.RAMUsage {
 		if v != receipt.RAMUsage[k] {
-			return fmt.Errorf("receipt not match, ram usage not same: %v != %v \n%v\n%v", v, receipt.RAMUsage[k], r, receipt)
+			return fmt.Errorf("%w, ram usage not same: %v != %v \n%v\n%v", ErrReceiptMismatch, v, receipt.RAMUsage[k], r, receipt)
 		}
 	}
 	if len(r.Receipts) != len(receipt.Receipts) {
-		return fmt.Errorf("receipt not match, receipts length not same: %v != %v \n%v\n%v", len(r.Receipts), len(receipt.Receipts), r, receipt)
+		return fmt.Errorf("%w, receipts length not same: %v != %v \n%v\n%v", ErrReceiptMismatch, len(r.Receipts), len(receipt.Receipts), r, receipt)
 	}
 	for i, br := range r.Receipts {
 		if br.FuncName != receipt.Receipts[i].FuncName {
-			return fmt.Errorf("receipt not match, funcname not same: %v != %v \n%v\n%v", br.FuncName, receipt.Receipts[i].FuncName, r, receipt)
+			return fmt.Errorf("%w, funcname not same: %v != %v \n%v\n%v", ErrReceiptMismatch, br.FuncName, receipt.Receipts[i].FuncName, r, receipt)
 		}
 		if br.Content != receipt.Receipts[i].Content {
-			return fmt.Errorf("receipt not match, content not same: %v != %v \n%v\n%v", br.Content, receipt.Receipts[i].Content, r, receipt)
+			return fmt.Errorf("%w, content not same: %v != %v \n%v\n%v", ErrReceiptMismatch, br.Content, receipt.Receipts[i].Content, r, receipt)
 		}
 	}
 	if len(r.Returns) != len(receipt.Returns) {
-		return fmt.Errorf("receipt not match, returns length not same: %v != %v \n%v\n%v", len(r.Returns), len(receipt.Returns), r, receipt)
+		return fmt.Errorf("%w, returns length not same: %v != %v \n%v\n%v", ErrReceiptMismatch, len(r.Returns), len(receipt.Returns), r, receipt)
 	}
 	for i, br := range r.Returns {
 		if br != receipt.Returns[i] {
-			return fmt.Errorf("receipt not match, returns not same: %v != %v \n%v\n%v", br, receipt.Returns[i], r, receipt)
+			return fmt.Errorf("%w, returns not same: %v != %v \n%v\n%v", ErrReceiptMismatch, br, receipt.Returns[i], r, receipt)
 		}
 	}
 	return nil
 }
 
-func baseVerify(engine vm.Isolator, c *Config, txs []*tx.Tx, receipts []*tx.TxReceipt, blk *block.Block) error {
-	blockGasLimit := common.MaxBlockGasLimit
-	blockGas := int64(0)
+// blockGasUsed returns the gas used by the receipts.
+func blockGasUsed(receipts []*tx.TxReceipt) int64 {
+	gas := int64(0)
 	for _, r := range receipts {
-		blockGas += r.GasUsage
+		gas += r.GasUsage
 	}
-	if blockGas > blockGasLimit {
+	return gas
+}
+
+// checkBlockGas checks the gas used by the txs except the block base tx.
+func checkBlockGas(blk *block.Block, limits *host.BlockLimits) error {
+	blockGas := blockGasUsed(blk.Receipts[1:])
+	if blockGas > limits.GasLimit {
 		return fmt.Errorf(
 			"Block %v include gas %v, exceeds maximum limit %v",
 			common.Base58Encode(blk.HeadHash()),
 			blockGas/100,
-			blockGasLimit/100,
+			limits.GasLimit/100,
 		)
 	}
+	return nil
+}
+
+// checkBlockTxs checks the tx count of block and the tx count of each publisher except the deferred txs,
+// which are limited in block generation, since fork 3.12.0.
+func checkBlockTxs(blk *block.Block, limits *host.BlockLimits) error {
+	if !blk.Head.Rules().IsFork3_12_0 {
+		return nil
+	}
+	if len(blk.Txs) > limits.TxLimit {
+		return fmt.Errorf("block %v include %v txs, exceeds maximum limit %v", common.Base58Encode(blk.HeadHash()), len(blk.Txs), limits.TxLimit)
+	}
+	senderCount := make(map[string]int)
+	for _, t := range blk.Txs[1:] {
+		if t.IsDefer() {
+			continue
+		}
+		senderCount[t.Publisher]++
+		if senderCount[t.Publisher] > limits.TxLimitSameSender {
+			return fmt.Errorf("block %v include more than %v txs of %v", common.Base58Encode(blk.HeadHash()), limits.TxLimitSameSender, t.Publisher)
+		}
+	}
+	return nil
+}
 
+func baseVerify(engine vm.Isolator, c *Config, txs []*tx.Tx, receipts []*tx.TxReceipt, blk *block.Block) error {
 	for k, t := range txs {
-		err := verify(engine, t, receipts[k], c.TxTimeLimit, false, blk)
+		err := verify(engine, t, receipts[k], c.TxTimeLimit, false, blk, c.StateDiffs)
 		if err != nil {
 			return err
 		}
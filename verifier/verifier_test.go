@@ -1,14 +1,24 @@
 package verifier
 
 import (
+	"fmt"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/iost-official/go-iost/v3/account"
+	"github.com/iost-official/go-iost/v3/common"
 	"github.com/iost-official/go-iost/v3/core/block"
 	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/core/txpool"
+	"github.com/iost-official/go-iost/v3/core/version"
+	"github.com/iost-official/go-iost/v3/crypto"
 	"github.com/iost-official/go-iost/v3/db"
+	"github.com/iost-official/go-iost/v3/vm"
+	"github.com/iost-official/go-iost/v3/vm/database"
+	"github.com/iost-official/go-iost/v3/vm/host"
+	"github.com/iost-official/go-iost/v3/vm/native"
+	"github.com/stretchr/testify/assert"
 )
 
 type mockTxIter struct {
@@ -59,3 +69,118 @@ func TestVerifier_Gen(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestSettleDeferTxs(t *testing.T) {
+	mvccdb, err := db.NewMVCCDB("mvcc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("mvcc")
+	defer mvccdb.Close()
+
+	now := time.Now().UnixNano()
+	// the deferred tx of missed is expired, since no block is produced in its window
+	missed := tx.NewTx(nil, nil, 100000, 100, now+int64(time.Minute), int64(time.Hour), 0)
+	missed.Time = now - 2*int64(time.Hour)
+	due := tx.NewTx(nil, nil, 100000, 100, now+int64(time.Minute), int64(time.Second), 0)
+	due.Time = now - 2*int64(time.Second)
+	v := database.NewVisitor(100, mvccdb, version.NewRules(0))
+	for _, d := range []*tx.Tx{missed, due} {
+		v.PutDelaytx(common.Base58Encode(d.Hash()), d.DeferTx().ExecTime(), string(d.Encode()))
+	}
+	v.Commit()
+
+	blk := &block.Block{
+		Head: &block.BlockHead{
+			Number: 0,
+			Time:   now,
+		},
+	}
+	txs, err := settleDeferTxs(blk, mvccdb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(txs) != 1 || string(txs[0].Hash()) != string(due.DeferTx().Hash()) {
+		t.Fatalf("expect the deferred tx of due, got %v", txs)
+	}
+	v = database.NewVisitor(100, mvccdb, version.NewRules(0))
+	if v.Delaytx(common.Base58Encode(missed.Hash())) != "" {
+		t.Fatal("expired delay tx should be removed from schedule")
+	}
+	if v.Delaytx(common.Base58Encode(due.Hash())) == "" {
+		t.Fatal("due delay tx should be kept in schedule")
+	}
+}
+
+func TestTraceInBlock(t *testing.T) {
+	s := NewSimulator()
+	defer s.Clear()
+	s.SetContract(native.SystemABI())
+	kp, err := account.NewKeyPair(nil, crypto.Secp256k1)
+	assert.NoError(t, err)
+	s.SetAccount(account.NewAccountFromKeys("user_0", kp.ReadablePubkey(), kp.ReadablePubkey()))
+	s.SetGas("user_0", 1e8)
+	// the expired delay tx is purged from schedule in the block and its replay
+	missed := tx.NewTx(nil, nil, 100000, 100, s.Head.Time+int64(time.Minute), int64(time.Hour), 0)
+	missed.Time = s.Head.Time - 2*int64(time.Hour)
+	s.Visitor.PutDelaytx(common.Base58Encode(missed.Hash()), missed.DeferTx().ExecTime(), string(missed.Encode()))
+	s.Visitor.Commit()
+	s.Mvcc.Commit("setup")
+
+	pool := txpool.NewSortedTxMap()
+	for k := 0; k < 3; k++ {
+		trx := tx.NewTx([]*tx.Action{{
+			Contract:   "system.iost",
+			ActionName: "receipt",
+			Data:       fmt.Sprintf(`["%v"]`, k),
+		}}, nil, 1000000, 100, s.Head.Time+int64(time.Minute), 0, 0)
+		trx.Time = s.Head.Time - int64(k)
+		stx, err := tx.SignTx(trx, "user_0", []*account.KeyPair{kp})
+		assert.NoError(t, err)
+		pool.Add(stx)
+	}
+
+	c := &Config{Timeout: 10 * time.Second, TxTimeLimit: time.Second}
+	db := s.Mvcc.Fork()
+	blk := &block.Block{Head: s.Head}
+	baseTx := &tx.Tx{
+		Publisher: "base.iost",
+		GasLimit:  100000000,
+		GasRatio:  100,
+		Time:      blk.Head.Time,
+		ChainID:   tx.ChainID,
+	}
+	isolator := &vm.Isolator{}
+	r, err := blockBaseExec(blk, db, isolator, baseTx, c)
+	assert.NoError(t, err)
+	blk.Txs = append(blk.Txs, baseTx)
+	blk.Receipts = append(blk.Receipts, r)
+	deferTxs, err := settleDeferTxs(blk, db)
+	assert.NoError(t, err)
+	assert.Empty(t, deferTxs)
+	assert.NoError(t, baseGen(blk, db, NewProvider(pool), isolator, c, host.ReadBlockLimits(db, blk.Head.Rules())))
+	assert.Len(t, blk.Txs, 4)
+
+	var e Executor
+	for k := 1; k < len(blk.Txs); k++ {
+		r, frames, err := e.TraceInBlock(blk, s.Mvcc.Fork(), blk.Txs[k].Hash(), c)
+		assert.NoError(t, err)
+		assert.Equal(t, blk.Receipts[k].Hash(), r.Hash())
+		assert.NotEmpty(t, frames)
+	}
+	v := database.NewVisitor(100, s.Mvcc, blk.Head.Rules())
+	assert.NotEmpty(t, v.Delaytx(common.Base58Encode(missed.Hash())), "db isn't changed by the trace")
+}
+
+func TestCheckReceiptEqual(t *testing.T) {
+	receipt := func(code tx.StatusCode, gas int64) *tx.TxReceipt {
+		return &tx.TxReceipt{TxHash: []byte("hash"), Status: &tx.Status{Code: code}, GasUsage: gas}
+	}
+	assert.NoError(t, checkReceiptEqual(receipt(tx.Success, 100), receipt(tx.Success, 100)))
+	assert.ErrorIs(t, checkReceiptEqual(receipt(tx.Success, 100), receipt(tx.Success, 200)), ErrReceiptMismatch)
+	assert.ErrorIs(t, checkReceiptEqual(receipt(tx.Success, 100), receipt(tx.ErrorRuntime, 100)), ErrReceiptMismatch)
+	assert.ErrorIs(t, checkReceiptEqual(receipt(tx.ErrorTimeout, 100), receipt(tx.Success, 100)), ErrReceiptMismatch)
+	err := checkReceiptEqual(receipt(tx.Success, 100), receipt(tx.ErrorTimeout, 100))
+	assert.ErrorIs(t, err, errTxTimeout)
+	assert.NotErrorIs(t, err, ErrReceiptMismatch, "the local timeout doesn't prove the receipt is wrong")
+}
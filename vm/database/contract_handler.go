@@ -1,12 +1,33 @@
 package database
 
 import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
 	"github.com/iost-official/go-iost/v3/core/contract"
 )
 
 // ContractPrefix ...
 const ContractPrefix = "c-"
 
+// The version history of contract is kept as:
+//
+//	ch-<id>  ->  count of versions
+//	ch-<id>-<version>  ->  json of ContractVersion
+const contractHistoryPrefix = "ch-"
+
+// ContractVersion is a record in the version history of contract
+type ContractVersion struct {
+	Version     int64           `json:"version"`
+	CodeHash    string          `json:"codeHash"`
+	Lang        string          `json:"lang"`
+	Abi         []*contract.ABI `json:"abi"`
+	BlockNumber int64           `json:"blockNumber"`
+	TxHash      string          `json:"txHash"`
+	Updater     string          `json:"updater"`
+}
+
 // ContractHandler ...
 type ContractHandler struct {
 	db database
@@ -41,3 +62,43 @@ func (m *ContractHandler) HasContract(key string) bool {
 func (m *ContractHandler) DelContract(key string) {
 	m.db.Del(ContractPrefix + key)
 }
+
+func contractVersionKey(id string, version int64) string {
+	return fmt.Sprintf("%v%v%v%010d", contractHistoryPrefix, id, Separator, version)
+}
+
+// ContractVersionCount returns the count of versions in the history of contract
+func (m *ContractHandler) ContractVersionCount(id string) int64 {
+	n, err := strconv.ParseInt(m.db.Get(contractHistoryPrefix+id), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// AddContractVersion appends the version to the history of contract, the version number is assigned from 1.
+// It returns the length of the saved record.
+func (m *ContractHandler) AddContractVersion(id string, v *ContractVersion) int {
+	v.Version = m.ContractVersionCount(id) + 1
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	m.db.Put(contractVersionKey(id, v.Version), string(b))
+	m.db.Put(contractHistoryPrefix+id, strconv.FormatInt(v.Version, 10))
+	return len(b)
+}
+
+// ContractHistory returns the version history of contract, from the oldest to the newest
+func (m *ContractHandler) ContractHistory(id string) []*ContractVersion {
+	n := m.ContractVersionCount(id)
+	history := make([]*ContractVersion, 0, n)
+	for i := int64(1); i <= n; i++ {
+		v := &ContractVersion{}
+		if err := json.Unmarshal([]byte(m.db.Get(contractVersionKey(id, i))), v); err != nil {
+			continue
+		}
+		history = append(history, v)
+	}
+	return history
+}
@@ -13,6 +13,7 @@ type Visitor struct {
 	GasHandler
 	RAMHandler
 	VoteHandler
+	DelaytxHandler
 }
 
 // NewVisitor get a visitor of a DB, with cache length determined
@@ -26,6 +27,7 @@ func NewVisitor(cacheLength int, cb IMultiValue, rules *version.Rules) *Visitor
 		ContractHandler: ContractHandler{cachedDB},
 		TokenHandler:    TokenHandler{cachedDB},
 		Token721Handler: Token721Handler{cachedDB},
+		DelaytxHandler:  DelaytxHandler{cachedDB},
 	}
 	v.GasHandler = GasHandler{v.BasicHandler, v.MapHandler}
 	v.RAMHandler = RAMHandler{v.BasicHandler}
@@ -50,6 +52,7 @@ func NewBatchVisitor(lruDB *LRU) *Visitor {
 		ContractHandler: ContractHandler{cachedDB},
 		TokenHandler:    TokenHandler{cachedDB},
 		Token721Handler: Token721Handler{cachedDB},
+		DelaytxHandler:  DelaytxHandler{cachedDB},
 	}
 	v.GasHandler = GasHandler{v.BasicHandler, v.MapHandler}
 	v.RAMHandler = RAMHandler{v.BasicHandler}
@@ -178,6 +178,133 @@ func TestMultiVisitor(t *testing.T) {
 
 }
 
+func TestDelaytx(t *testing.T) {
+	mvccdb, err := db.NewMVCCDB("mvcc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeMVCCDB(mvccdb)
+
+	v := NewVisitor(100, mvccdb, version.NewRules(0))
+	v.PutDelaytx("h2", 200, "tx2")
+	v.PutDelaytx("h1", 100, "tx1")
+	v.PutDelaytx("h3", 300, "tx3")
+	v.Commit()
+	if d := v.Delaytx("h1"); d != "tx1" {
+		t.Fatal(d)
+	}
+
+	due, err := DueDelaytxs(mvccdb, 200, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sliceEqual(due, []string{"tx1", "tx2"}) {
+		t.Fatal(due)
+	}
+
+	v.DelDelaytx("h1")
+	v.DelDelaytx("h4")
+	v.Commit()
+	if d := v.Delaytx("h1"); d != "" {
+		t.Fatal(d)
+	}
+	due, err = DueDelaytxs(mvccdb, 1000, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sliceEqual(due, []string{"tx2", "tx3"}) {
+		t.Fatal(due)
+	}
+
+	// the missed delay txs are still due until they are removed
+	due, err = DueDelaytxs(mvccdb, 1000000, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sliceEqual(due, []string{"tx2"}) {
+		t.Fatal(due)
+	}
+	if err := RemoveDelaytx(mvccdb, "h2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := RemoveDelaytx(mvccdb, "h4"); err != nil {
+		t.Fatal(err)
+	}
+	v = NewVisitor(100, mvccdb, version.NewRules(0))
+	if d := v.Delaytx("h2"); d != "" {
+		t.Fatal(d)
+	}
+	due, err = DueDelaytxs(mvccdb, 1000000, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sliceEqual(due, []string{"tx3"}) {
+		t.Fatal(due)
+	}
+}
+
+func TestContractHistory(t *testing.T) {
+	mvccdb, err := db.NewMVCCDB("mvcc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeMVCCDB(mvccdb)
+
+	v := NewVisitor(100, mvccdb, version.NewRules(0))
+	if h := v.ContractHistory("c1"); len(h) != 0 {
+		t.Fatal(h)
+	}
+	v.AddContractVersion("c1", &ContractVersion{CodeHash: "h1", BlockNumber: 10})
+	v.AddContractVersion("c2", &ContractVersion{CodeHash: "h2", BlockNumber: 11})
+	v.AddContractVersion("c1", &ContractVersion{CodeHash: "h3", BlockNumber: 12})
+	v.Commit()
+
+	h := v.ContractHistory("c1")
+	if len(h) != 2 || h[0].Version != 1 || h[0].CodeHash != "h1" || h[1].Version != 2 || h[1].BlockNumber != 12 {
+		t.Fatal(h)
+	}
+	if n := v.ContractVersionCount("c2"); n != 1 {
+		t.Fatal(n)
+	}
+}
+
+func TestStateDiff(t *testing.T) {
+	mvccdb, err := db.NewMVCCDB("mvcc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeMVCCDB(mvccdb)
+
+	v := NewVisitor(100, mvccdb, version.NewRules(0))
+	v.Put("token.iost-supply", MustMarshal(int64(10)))
+	v.MPut("auth.iost-auth", "admin", MustMarshal("a"))
+	v.Commit()
+
+	v.Put("token.iost-supply", MustMarshal(int64(20)))
+	v.MPut("auth.iost-auth", "admin", MustMarshal("b"))
+	v.MPut("auth.iost-auth", "user", MustMarshal("c", "user"))
+	v.Put("token.iost-unchanged", MustMarshal(int64(10)))
+	v.Commit()
+	v.Put("token.iost-unchanged", MustMarshal(int64(10)))
+	v.Del("token.iost-supply")
+	v.MPut("auth.iost-auth", "admin", MustMarshal("d"))
+	v.MPut("auth.iost-auth", "user", MustMarshal("e", "user"))
+
+	diff := v.StateDiff()
+	if len(diff) != 3 {
+		t.Fatal(diff)
+	}
+	if c := diff[0]; c.Contract != "token.iost" || c.Key != "supply" || c.Field != "" || c.OldValue != "20" || c.NewValue != "" {
+		t.Fatal(c)
+	}
+	if c := diff[1]; c.Contract != "auth.iost" || c.Key != "auth" || c.Field != "admin" || c.OldValue != "b" || c.NewValue != "d" {
+		t.Fatal(c)
+	}
+	if c := diff[2]; c.Field != "user" || c.OldValue != "c" || c.NewValue != "e" {
+		t.Fatal(c)
+	}
+}
+
 func closeMVCCDB(m db.MVCCDB) {
 	m.Close()
 	os.RemoveAll("mvcc")
@@ -0,0 +1,85 @@
+package database
+
+import "fmt"
+
+// Delay txs are scheduled in state with keys ordered by the exec time, so that the producer can
+// find the due ones by range:
+//
+//	delaytx-h-<hash>  ->  key of the queue
+//	delaytx-q-<exec time>-<hash>  ->  encoded delay tx
+const (
+	delaytxHashPrefix  = "delaytx-h-"
+	delaytxQueuePrefix = "delaytx-q-"
+)
+
+// DelaytxHandler handler of scheduled delay txs
+type DelaytxHandler struct {
+	db database
+}
+
+// DelaytxQueueKey returns the key of the delay tx in queue, an empty hash returns the first key of exec time.
+func DelaytxQueueKey(execTime int64, hash string) string {
+	return fmt.Sprintf("%v%019d%v%v", delaytxQueuePrefix, execTime, Separator, hash)
+}
+
+// PutDelaytx schedules the encoded delay tx to be executed at execTime
+func (m *DelaytxHandler) PutDelaytx(hash string, execTime int64, encoded string) {
+	k := DelaytxQueueKey(execTime, hash)
+	m.db.Put(delaytxHashPrefix+hash, k)
+	m.db.Put(k, encoded)
+}
+
+// Delaytx returns the encoded delay tx of hash, empty if it isn't scheduled
+func (m *DelaytxHandler) Delaytx(hash string) string {
+	k := m.db.Get(delaytxHashPrefix + hash)
+	if k == NilPrefix {
+		return ""
+	}
+	v := m.db.Get(k)
+	if v == NilPrefix {
+		return ""
+	}
+	return v
+}
+
+// DelDelaytx removes the delay tx from schedule, if it isn't scheduled, do nothing
+func (m *DelaytxHandler) DelDelaytx(hash string) {
+	k := m.db.Get(delaytxHashPrefix + hash)
+	if k == NilPrefix {
+		return
+	}
+	m.db.Del(delaytxHashPrefix + hash)
+	m.db.Del(k)
+}
+
+// DueDelaytxs returns the encoded delay txs whose exec time is not after to, in order of exec time.
+// The missed ones are returned too, so that they are executed or removed after all.
+func DueDelaytxs(db IRangeValue, to int64, limit int) ([]string, error) {
+	keys, err := db.KeysByRange(StateTable, DelaytxQueueKey(0, ""), DelaytxQueueKey(to+1, ""), limit)
+	if err != nil {
+		return nil, err
+	}
+	txs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		v, err := db.Get(StateTable, k)
+		if err != nil {
+			return nil, err
+		}
+		if v != "" {
+			txs = append(txs, v)
+		}
+	}
+	return txs, nil
+}
+
+// RemoveDelaytx removes the delay tx from schedule in db, it's used to purge the expired ones out of txs.
+func RemoveDelaytx(db IMultiValue, hash string) error {
+	k, err := db.Get(StateTable, delaytxHashPrefix+hash)
+	if err != nil || k == "" {
+		return err
+	}
+	if err := db.Del(StateTable, delaytxHashPrefix+hash); err != nil {
+		return err
+	}
+	return db.Del(StateTable, k)
+}
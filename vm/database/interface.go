@@ -9,3 +9,9 @@ type IMultiValue interface {
 	Del(table string, key string) error
 	Has(table string, key string) (bool, error)
 }
+
+// IRangeValue is the mvcc database which can list keys by range
+type IRangeValue interface {
+	IMultiValue
+	KeysByRange(table string, from string, to string, limit int) ([]string, error)
+}
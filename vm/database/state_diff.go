@@ -0,0 +1,90 @@
+package database
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/tx"
+)
+
+// StateDiff returns the changes of the write cache to be committed, sorted by storage key.
+func (m *RollbackHandler) StateDiff() []*tx.StateChange {
+	keys := make([]string, 0, len(m.wc.m))
+	for k := range m.wc.m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	diff := make([]*tx.StateChange, 0, len(keys))
+	for _, k := range keys {
+		oldValue := m.wc.db.Get(k)
+		newValue := NilPrefix
+		if r := m.wc.m[k]; r.mode != Delete {
+			newValue = r.value
+		}
+		if oldValue == newValue {
+			continue
+		}
+		c, ok := parseStorageKey(k)
+		if !ok {
+			continue
+		}
+		if strings.HasPrefix(k, ContractPrefix) {
+			c.OldValue = contractDigest(oldValue)
+			c.NewValue = contractDigest(newValue)
+		} else {
+			c.OldValue = readableValue(oldValue)
+			c.NewValue = readableValue(newValue)
+		}
+		diff = append(diff, c)
+	}
+	return diff
+}
+
+// parseStorageKey splits the storage key into contract, key and field.
+// The field lists of maps are skipped because they are only indexes of map fields.
+func parseStorageKey(k string) (*tx.StateChange, bool) {
+	switch {
+	case strings.HasPrefix(k, BasicPrefix):
+		s := strings.SplitN(strings.TrimPrefix(k, BasicPrefix), Separator, 2)
+		if len(s) != 2 {
+			return nil, false
+		}
+		return &tx.StateChange{Contract: s[0], Key: s[1]}, true
+	case strings.HasPrefix(k, MapPrefix):
+		s := strings.SplitN(strings.TrimPrefix(k, MapPrefix), Separator, 3)
+		if len(s) != 3 {
+			return nil, false
+		}
+		return &tx.StateChange{Contract: s[0], Key: s[1], Field: s[2]}, true
+	case strings.HasPrefix(k, ContractPrefix):
+		return &tx.StateChange{Contract: strings.TrimPrefix(k, ContractPrefix)}, true
+	default:
+		return &tx.StateChange{Key: k}, true
+	}
+}
+
+// readableValue returns the unmarshaled value, or base58 of the raw value if it isn't readable.
+func readableValue(v string) string {
+	if v == "" || v == NilPrefix {
+		return ""
+	}
+	o := Unmarshal(v)
+	if _, ok := o.(error); ok {
+		if utf8.ValidString(v) {
+			return v
+		}
+		return common.Base58Encode([]byte(v))
+	}
+	return fmt.Sprint(o)
+}
+
+// contractDigest returns the hash of the encoded contract instead of the code.
+func contractDigest(v string) string {
+	if v == "" || v == NilPrefix {
+		return ""
+	}
+	return common.Base58Encode(common.Sha3([]byte(v)))
+}
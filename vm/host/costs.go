@@ -18,6 +18,7 @@ var (
 		"SetCodePrice":     contract.NewCost(0, 0, 70),
 		"OpPrice":          contract.NewCost(0, 0, 1),
 		"ErrPrice":         contract.NewCost(0, 0, 1),
+		"DelaytxPrice":     contract.NewCost(0, 1, 0),
 	}
 )
 
@@ -43,6 +44,13 @@ func SetCodeCost(size int) contract.Cost {
 	return cost
 }
 
+// DelaytxCost returns the cost of scheduling a delay tx based on its size
+func DelaytxCost(size int) contract.Cost {
+	cost := Costs["PutCost"]
+	cost.AddAssign(Costs["DelaytxPrice"].Multiply(int64(size)))
+	return cost
+}
+
 // CommonErrorCost returns cost increased by stack layer
 func CommonErrorCost(layer int) contract.Cost {
 	return Costs["ErrPrice"].Multiply(int64(layer * 10))
@@ -16,7 +16,9 @@ var (
 	ErrContractExists     = errors.New("contract exists")
 	ErrAbiHasInternalFunc = errors.New("abi has internal function")
 	ErrUpdateRefused      = errors.New("update refused")
+	ErrMigrateArgs        = errors.New("migrate should have no args")
 	ErrDestroyRefused     = errors.New("destroy refused")
+	ErrLangUnsupported    = errors.New("contract language unsupported")
 
 	ErrCoinExists         = errors.New("coin exists")
 	ErrCoinNotExists      = errors.New("coin not exists")
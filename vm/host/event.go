@@ -1,8 +1,11 @@
 package host
 
 import (
+	"strings"
+
 	"github.com/iost-official/go-iost/v3/core/contract"
 	"github.com/iost-official/go-iost/v3/core/event"
+	"github.com/iost-official/go-iost/v3/core/tx"
 )
 
 // EventPoster the event handler in host
@@ -17,8 +20,59 @@ func NewEventPoster(h *Host) EventPoster {
 
 // PostEvent post the event
 func (p *EventPoster) PostEvent(data string) contract.Cost {
-	e := event.NewEvent(event.ContractEvent, data)
-	event.GetCollector().Post(e,
-		&event.Meta{ContractID: p.h.Context().Value("contract_name").(string)})
+	p.h.postEvent(event.NewEvent(event.ContractEvent, data))
 	return EventCost(len(data))
 }
+
+// keptEvent is an event waiting for the status of tx.
+type keptEvent struct {
+	e    *event.Event
+	meta *event.Meta
+}
+
+func (h *Host) eventMeta() *event.Meta {
+	meta := &event.Meta{}
+	meta.ContractID, _ = h.ctx.Value("contract_name").(string)
+	meta.ActionName, _ = h.ctx.Value("abi_name").(string)
+	meta.Publisher, _ = h.ctx.Value("publisher").(string)
+	signers, _ := h.ctx.Value("signer_list").(map[string]bool)
+	for s := range signers {
+		meta.Signers = append(meta.Signers, strings.Split(s, "@")[0])
+	}
+	return meta
+}
+
+// postEvent keeps the event until TakeKeptEvents if KeepEvents is called, otherwise posts it at once.
+func (h *Host) postEvent(e *event.Event) {
+	meta := h.eventMeta()
+	if kept, ok := h.ctx.GValue("events").(KeptEvents); ok {
+		h.ctx.GSet("events", append(kept, &keptEvent{e: e, meta: meta}))
+		return
+	}
+	event.GetCollector().Post(e, meta)
+}
+
+// KeepEvents makes events of the tx wait for its status.
+func (h *Host) KeepEvents() {
+	h.ctx.GSet("events", make(KeptEvents, 0))
+}
+
+// KeptEvents are the events of a tx waiting to be posted.
+type KeptEvents []*keptEvent
+
+// TakeKeptEvents returns the kept events with the status code of the tx, and clears them in the host.
+func (h *Host) TakeKeptEvents(code tx.StatusCode) KeptEvents {
+	kept, _ := h.ctx.GValue("events").(KeptEvents)
+	for _, k := range kept {
+		k.meta.StatusCode = code
+	}
+	h.ctx.GSet("events", make(KeptEvents, 0))
+	return kept
+}
+
+// Post posts the events to the collector.
+func (es KeptEvents) Post() {
+	for _, k := range es {
+		event.GetCollector().Post(k.e, k.meta)
+	}
+}
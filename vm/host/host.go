@@ -38,6 +38,7 @@ type Host struct {
 	ctx     *Context
 	db      *database.Visitor
 	monitor Monitor
+	tracer  *Tracer
 
 	deadline time.Time
 }
@@ -105,6 +106,9 @@ func (h *Host) CallWithAuth(contract, api, jarg string) ([]any, contract.Cost, e
 
 func (h *Host) checkAbiValid(c *contract.Contract) (contract.Cost, error) {
 	cost := contract.Cost0()
+	if c.Info.Lang == "wasm" && !h.IsFork3_13_0 {
+		return cost, ErrLangUnsupported
+	}
 	err := h.monitor.Validate(c)
 	cost.AddAssign(CodeSavageCost(len(c.Encode())))
 	return cost, err
@@ -223,6 +227,9 @@ func (h *Host) SetCode(c *contract.Contract, owner string) (contract.Cost, error
 	c.Info.Abi = c.Info.Abi[:len(c.Info.Abi)-1]
 	h.db.SetContract(c)
 
+	if err == nil && h.IsFork3_14_0 {
+		cost.AddAssign(h.addContractVersion(c, owner))
+	}
 	return cost, err
 }
 
@@ -266,6 +273,14 @@ func (h *Host) UpdateCode(c *contract.Contract, id database.SerializedJSON) (con
 		return cost, err
 	}
 
+	var migrate *contract.ABI
+	if h.IsFork3_14_0 {
+		migrate = c.ABI("migrate")
+		if migrate != nil && len(migrate.Args) != 0 {
+			return cost, ErrMigrateArgs
+		}
+	}
+
 	code, err := h.monitor.Compile(c)
 	cost.AddAssign(CodeSavageCost(len(c.Code)))
 	if err != nil {
@@ -278,20 +293,57 @@ func (h *Host) UpdateCode(c *contract.Contract, id database.SerializedJSON) (con
 	h.db.SetContract(c)
 
 	publisher := h.Context().Value("publisher").(string)
+	if h.IsFork3_14_0 {
+		cost.AddAssign(h.addContractVersion(c, publisher))
+	}
 	c.OrigCode = ""
 	l := len(c.Encode())
 	cost.AddAssign(contract.Cost{Data: int64(l - oldL), DataList: []contract.DataItem{
 		{Payer: publisher, Val: int64(l - oldL)},
 	}})
 
+	if migrate != nil {
+		_, cost0, err := h.Call(c.ID, "migrate", "[]")
+		cost.AddAssign(cost0)
+		if err != nil {
+			return cost, fmt.Errorf("call migrate: %v", err)
+		}
+	}
+
 	return cost, nil
 }
 
+// addContractVersion records the contract set by tx in its version history, the record is paid by payer.
+func (h *Host) addContractVersion(c *contract.Contract, payer string) contract.Cost {
+	v := &database.ContractVersion{
+		CodeHash: common.Base58Encode(common.Sha3([]byte(c.OrigCode))),
+		Lang:     c.Info.Lang,
+		Abi:      c.Info.Abi,
+		Updater:  payer,
+	}
+	v.BlockNumber, _ = h.Context().Value("number").(int64)
+	v.TxHash, _ = h.Context().Value("tx_hash").(string)
+	l := h.db.AddContractVersion(c.ID, v)
+	return contract.Cost{Data: int64(l), DataList: []contract.DataItem{
+		{Payer: payer, Val: int64(l)},
+	}}
+}
+
 // Logger get a log in host
 func (h *Host) Logger() *ilog.Logger {
 	return h.logger
 }
 
+// Tracer returns the tracer of calls, nil if the tx isn't traced
+func (h *Host) Tracer() *Tracer {
+	return h.tracer
+}
+
+// SetTracer sets the tracer of calls
+func (h *Host) SetTracer(t *Tracer) {
+	h.tracer = t
+}
+
 // DB get current version mvccdb
 func (h *Host) DB() *database.Visitor {
 	return h.db
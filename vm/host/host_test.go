@@ -1,12 +1,15 @@
 package host
 
 import (
+	"errors"
 	"testing"
 	"time"
 
 	. "github.com/golang/mock/gomock"
+	"github.com/iost-official/go-iost/v3/core/contract"
 	"github.com/iost-official/go-iost/v3/core/version"
 	"github.com/iost-official/go-iost/v3/vm/database"
+	"github.com/stretchr/testify/assert"
 )
 
 func watchTime(f func()) time.Duration {
@@ -299,3 +302,30 @@ func TestHost_MapKeys_Owner(t *testing.T) {
 		t.Fatal(ans)
 	}
 }
+
+type validateMonitor struct {
+	Monitor
+	err error
+}
+
+func (m *validateMonitor) Validate(*contract.Contract) error {
+	return m.err
+}
+
+func TestHost_SetCode_Wasm(t *testing.T) {
+	c := &contract.Contract{
+		ID:   "Contractwasm",
+		Code: "\x00asm",
+		Info: &contract.Info{Lang: "wasm", Version: "1.0.0"},
+	}
+	validated := errors.New("validated")
+	monitor := &validateMonitor{err: validated}
+
+	host := NewHost(NewContext(nil), nil, &version.Rules{}, monitor, nil)
+	_, err := host.SetCode(c, "user_0")
+	assert.Equal(t, ErrLangUnsupported, err)
+
+	host = NewHost(NewContext(nil), nil, &version.Rules{IsFork3_13_0: true}, monitor, nil)
+	_, err = host.SetCode(c, "user_0")
+	assert.Equal(t, validated, err)
+}
@@ -1,8 +1,116 @@
 package host
 
-import "github.com/iost-official/go-iost/v3/core/contract"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/contract"
+	"github.com/iost-official/go-iost/v3/core/version"
+	"github.com/iost-official/go-iost/v3/vm/database"
+)
 
 // Setting in state db
 type Setting struct {
 	Costs map[string]contract.Cost `json:"costs"`
+	Block *BlockSetting            `json:"block,omitempty"`
+}
+
+// BlockSetting is the limits of block production, zero fields keep the default value.
+type BlockSetting struct {
+	GasLimit          int64 `json:"gasLimit"`
+	TxTimeLimit       int64 `json:"txTimeLimit"`    // millisecond
+	BlockTimeLimit    int64 `json:"blockTimeLimit"` // millisecond
+	TxLimit           int   `json:"txLimit"`
+	TxLimitSameSender int   `json:"txLimitSameSender"`
+}
+
+// BlockLimits is the limits of block production in effect.
+type BlockLimits struct {
+	GasLimit          int64
+	TxTimeLimit       time.Duration
+	BlockTimeLimit    time.Duration
+	TxLimit           int
+	TxLimitSameSender int
+}
+
+// DefaultBlockLimits returns the block limits before they are set in state db.
+func DefaultBlockLimits() *BlockLimits {
+	return &BlockLimits{
+		GasLimit:          common.MaxBlockGasLimit,
+		TxTimeLimit:       common.MaxTxTimeLimit,
+		BlockTimeLimit:    common.MaxBlockTimeLimit,
+		TxLimit:           50,
+		TxLimitSameSender: 10,
+	}
+}
+
+// upper bounds of block setting, so that the block can be produced and verified within its block interval
+var (
+	maxBlockGasLimit = 10 * common.MaxBlockGasLimit
+	maxBlockTxLimit  = 10000
+)
+
+// Validate checks the block setting.
+func (s *BlockSetting) Validate() error {
+	if s.GasLimit < 0 || s.TxTimeLimit < 0 || s.BlockTimeLimit < 0 || s.TxLimit < 0 || s.TxLimitSameSender < 0 {
+		return errors.New("block setting should not be negative")
+	}
+	l := DefaultBlockLimits()
+	l.apply(s)
+	if l.BlockTimeLimit >= common.BlockInterval {
+		return fmt.Errorf("block time limit should be less than block interval %v", common.BlockInterval)
+	}
+	if l.TxTimeLimit > l.BlockTimeLimit {
+		return errors.New("tx time limit should not exceed block time limit")
+	}
+	if l.GasLimit > maxBlockGasLimit {
+		return fmt.Errorf("block gas limit should not exceed %v", maxBlockGasLimit)
+	}
+	if l.TxLimit > maxBlockTxLimit {
+		return fmt.Errorf("block tx limit should not exceed %v", maxBlockTxLimit)
+	}
+	if l.TxLimitSameSender > l.TxLimit {
+		return errors.New("tx limit of the same sender should not exceed block tx limit")
+	}
+	return nil
+}
+
+func (l *BlockLimits) apply(s *BlockSetting) {
+	if s.GasLimit > 0 {
+		l.GasLimit = s.GasLimit
+	}
+	if s.TxTimeLimit > 0 {
+		l.TxTimeLimit = time.Duration(s.TxTimeLimit) * time.Millisecond
+	}
+	if s.BlockTimeLimit > 0 {
+		l.BlockTimeLimit = time.Duration(s.BlockTimeLimit) * time.Millisecond
+	}
+	if s.TxLimit > 0 {
+		l.TxLimit = s.TxLimit
+	}
+	if s.TxLimitSameSender > 0 {
+		l.TxLimitSameSender = s.TxLimitSameSender
+	}
+}
+
+// ReadBlockLimits reads the block limits from the host settings in db, they take effect since fork 3.12.0.
+func ReadBlockLimits(db database.IMultiValue, rules *version.Rules) *BlockLimits {
+	l := DefaultBlockLimits()
+	if !rules.IsFork3_12_0 {
+		return l
+	}
+	vi := database.NewVisitor(0, db, rules)
+	j, ok := database.Unmarshal(vi.MGet("system.iost"+database.Separator+"settings", "host")).(string)
+	if !ok {
+		return l
+	}
+	var s Setting
+	if err := json.Unmarshal([]byte(j), &s); err != nil || s.Block == nil || s.Block.Validate() != nil {
+		return l
+	}
+	l.apply(s.Block)
+	return l
 }
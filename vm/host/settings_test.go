@@ -0,0 +1,67 @@
+package host
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/version"
+	"github.com/iost-official/go-iost/v3/vm/database"
+	"github.com/stretchr/testify/assert"
+)
+
+type mapDB map[string]string
+
+func (m mapDB) Get(table string, key string) (string, error) {
+	if v, ok := m[table+"/"+key]; ok {
+		return v, nil
+	}
+	return "n", nil
+}
+
+func (m mapDB) Put(table string, key string, value string) error {
+	m[table+"/"+key] = value
+	return nil
+}
+
+func (m mapDB) Del(table string, key string) error {
+	delete(m, table+"/"+key)
+	return nil
+}
+
+func (m mapDB) Has(table string, key string) (bool, error) {
+	_, ok := m[table+"/"+key]
+	return ok, nil
+}
+
+func TestReadBlockLimits(t *testing.T) {
+	forked := &version.Rules{IsFork3_12_0: true}
+	db := mapDB{}
+	assert.Equal(t, DefaultBlockLimits(), ReadBlockLimits(db, forked))
+
+	vi := database.NewVisitor(0, db, forked)
+	vi.MPut("system.iost"+database.Separator+"settings", "host",
+		database.MustMarshal(`{"costs":{},"block":{"gasLimit":1000,"txTimeLimit":100,"txLimit":200}}`))
+	vi.Commit()
+
+	l := ReadBlockLimits(db, forked)
+	assert.Equal(t, int64(1000), l.GasLimit)
+	assert.Equal(t, 100*time.Millisecond, l.TxTimeLimit)
+	assert.Equal(t, common.MaxBlockTimeLimit, l.BlockTimeLimit)
+	assert.Equal(t, 200, l.TxLimit)
+	assert.Equal(t, 10, l.TxLimitSameSender)
+
+	assert.Equal(t, DefaultBlockLimits(), ReadBlockLimits(db, &version.Rules{}))
+}
+
+func TestBlockSettingValidate(t *testing.T) {
+	assert.NoError(t, (&BlockSetting{TxLimit: 100}).Validate())
+	assert.Error(t, (&BlockSetting{GasLimit: -1}).Validate())
+	assert.Error(t, (&BlockSetting{TxTimeLimit: 500, BlockTimeLimit: 400}).Validate())
+	assert.Error(t, (&BlockSetting{TxTimeLimit: 450}).Validate(), "tx time limit exceeds the default block time limit")
+	assert.Error(t, (&BlockSetting{BlockTimeLimit: 3000}).Validate(), "block time limit exceeds block interval")
+	assert.Error(t, (&BlockSetting{GasLimit: 100 * common.MaxBlockGasLimit}).Validate())
+	assert.Error(t, (&BlockSetting{TxLimit: 1000000}).Validate())
+	assert.Error(t, (&BlockSetting{TxLimit: 5, TxLimitSameSender: 6}).Validate())
+	assert.NoError(t, (&BlockSetting{GasLimit: 2 * common.MaxBlockGasLimit, BlockTimeLimit: 450, TxTimeLimit: 300, TxLimit: 1000}).Validate())
+}
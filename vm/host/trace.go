@@ -0,0 +1,84 @@
+package host
+
+import (
+	"fmt"
+
+	"github.com/iost-official/go-iost/v3/core/contract"
+)
+
+// CallFrame is a contract call traced in a tx.
+type CallFrame struct {
+	Contract string           `json:"contract"`
+	API      string           `json:"api"`
+	Args     string           `json:"args"`
+	Returns  []string         `json:"returns,omitempty"`
+	Error    string           `json:"error,omitempty"`
+	Gas      int64            `json:"gas"`
+	RAMUsage map[string]int64 `json:"ram_usage,omitempty"`
+	Logs     []string         `json:"logs,omitempty"`
+	Calls    []*CallFrame     `json:"calls,omitempty"`
+}
+
+// Tracer records the call tree of a tx.
+type Tracer struct {
+	frames []*CallFrame
+	stack  []*CallFrame
+}
+
+// NewTracer returns a new tracer.
+func NewTracer() *Tracer {
+	return &Tracer{}
+}
+
+// Enter starts a frame of contract call.
+func (t *Tracer) Enter(contractName, api, args string) {
+	f := &CallFrame{
+		Contract: contractName,
+		API:      api,
+		Args:     args,
+	}
+	if len(t.stack) == 0 {
+		t.frames = append(t.frames, f)
+	} else {
+		parent := t.stack[len(t.stack)-1]
+		parent.Calls = append(parent.Calls, f)
+	}
+	t.stack = append(t.stack, f)
+}
+
+// Exit finishes the current frame with its result. The gas of a frame includes its nested calls,
+// but the RAM usage doesn't.
+func (t *Tracer) Exit(rtn []any, cost contract.Cost, ramCost contract.Cost, err error) {
+	if len(t.stack) == 0 {
+		return
+	}
+	f := t.stack[len(t.stack)-1]
+	t.stack = t.stack[:len(t.stack)-1]
+	for _, r := range rtn {
+		f.Returns = append(f.Returns, fmt.Sprint(r))
+	}
+	if err != nil {
+		f.Error = err.Error()
+	}
+	f.Gas = cost.ToGas()
+	for _, d := range ramCost.DataList {
+		if f.RAMUsage == nil {
+			f.RAMUsage = make(map[string]int64)
+		}
+		f.RAMUsage[d.Payer] += d.Val
+	}
+}
+
+// Log records a console log in the current frame.
+func (t *Tracer) Log(level, msg string) {
+	if len(t.stack) == 0 {
+		return
+	}
+	f := t.stack[len(t.stack)-1]
+	f.Logs = append(f.Logs, level+": "+msg)
+}
+
+// Frames returns the traced calls of actions.
+func (t *Tracer) Frames() []*CallFrame {
+	return t.frames
+}
@@ -0,0 +1,32 @@
+package host
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/iost-official/go-iost/v3/core/contract"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracer(t *testing.T) {
+	tr := NewTracer()
+	tr.Enter("Contract1", "transfer", `["a"]`)
+	tr.Log("Info", "start")
+	tr.Enter("token.iost", "transfer", `["iost","a","b","1",""]`)
+	tr.Exit([]any{}, contract.NewCost(0, 1, 10), contract.Cost{DataList: []contract.DataItem{{Payer: "a", Val: 5}, {Payer: "a", Val: 3}}}, nil)
+	tr.Exit(nil, contract.NewCost(0, 2, 30), contract.Cost0(), errors.New("failed"))
+	tr.Log("Info", "ignored")
+
+	frames := tr.Frames()
+	assert.Len(t, frames, 1)
+	f := frames[0]
+	assert.Equal(t, "Contract1", f.Contract)
+	assert.Equal(t, "failed", f.Error)
+	assert.Equal(t, int64(50), f.Gas)
+	assert.Equal(t, []string{"Info: start"}, f.Logs)
+	assert.Nil(t, f.RAMUsage)
+	assert.Len(t, f.Calls, 1)
+	assert.Equal(t, "token.iost", f.Calls[0].Contract)
+	assert.Equal(t, int64(20), f.Calls[0].Gas)
+	assert.Equal(t, map[string]int64{"a": 8}, f.Calls[0].RAMUsage)
+}
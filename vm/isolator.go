@@ -1,6 +1,7 @@
 package vm
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -31,6 +32,7 @@ type Isolator struct {
 	blockBaseCtx  *host.Context
 	genesisMode   bool
 	blockBaseMode bool
+	replayMode    bool
 	limit         time.Duration
 }
 
@@ -41,6 +43,11 @@ func (i *Isolator) TriggerBlockBaseMode() {
 	i.blockBaseMode = true
 }
 
+// TriggerReplayMode start replay mode, in which committed txs post no events
+func (i *Isolator) TriggerReplayMode() {
+	i.replayMode = true
+}
+
 // Prepare Isolator
 func (i *Isolator) Prepare(bh *block.BlockHead, db *database.Visitor, logger *ilog.Logger) error {
 	if db.Contract("system.iost") == nil {
@@ -88,10 +95,47 @@ func (i *Isolator) PrepareTx(t *tx.Tx, limit time.Duration) error {
 		if err != nil {
 			return err
 		}
+		if t.IsDefer() {
+			err = i.checkDefer(t)
+			if err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
+// checkDefer checks that the deferred tx is of a scheduled delay tx.
+func (i *Isolator) checkDefer(t *tx.Tx) error {
+	encoded := i.h.DB().Delaytx(common.Base58Encode(t.ReferredTx))
+	if encoded == "" {
+		return fmt.Errorf("delay tx %v is not scheduled", common.Base58Encode(t.ReferredTx))
+	}
+	dt := &tx.Tx{}
+	err := dt.Decode([]byte(encoded))
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(dt.DeferTx().Hash(), t.Hash()) {
+		return fmt.Errorf("deferred tx not match delay tx %v", common.Base58Encode(t.ReferredTx))
+	}
+	return nil
+}
+
+// isDelay returns whether the tx should be scheduled instead of running actions.
+func (i *Isolator) isDelay() bool {
+	return i.t.Delay > 0 && !i.t.IsDefer()
+}
+
+// settleDelaytx schedules the succeeded delay tx, and removes the deferred tx from schedule whatever its status is.
+func (i *Isolator) settleDelaytx() {
+	if i.t.IsDefer() {
+		i.h.DB().DelDelaytx(common.Base58Encode(i.t.ReferredTx))
+	} else if i.isDelay() && i.tr.Status.Code == tx.Success {
+		i.h.DB().PutDelaytx(common.Base58Encode(i.t.Hash()), i.t.DeferTx().ExecTime(), string(i.t.Encode()))
+	}
+}
+
 func (i *Isolator) checkAuth(t *tx.Tx) error {
 	err := i.h.CheckSigners(t)
 	if err != nil {
@@ -167,10 +211,23 @@ func (i *Isolator) Run() (*tx.TxReceipt, error) { // nolint
 	i.h.Context().GSet("gas_limit", vmGasLimit)
 	i.h.Context().GSet("receipts", make([]*tx.Receipt, 0))
 	i.h.Context().GSet("amount_total", make(map[string]*common.Decimal))
+	i.h.KeepEvents()
 
 	i.tr = tx.NewTxReceipt(i.t.Hash())
 
-	for _, action := range i.t.Actions {
+	actions := i.t.Actions
+	if i.isDelay() {
+		// actions of delay tx are run by its deferred tx
+		actions = nil
+		cost := host.DelaytxCost(len(i.t.Encode()))
+		if vmGasLimit < cost.ToGas() {
+			i.tr.Status = &tx.Status{Code: tx.ErrorRuntime, Message: "out of gas"}
+			cost = contract.NewCost(0, 0, vmGasLimit)
+		}
+		i.h.PayCost(cost, i.publisherID)
+	}
+
+	for _, action := range actions {
 		actionCost, status, ret, receipts, err := i.runAction(*action)
 		ilog.Debugf("run action : %v, result is %v\n", action, status.Code)
 		ilog.Debugf("used cost %v\n", actionCost)
@@ -218,6 +275,8 @@ func (i *Isolator) Run() (*tx.TxReceipt, error) { // nolint
 		i.h.Context().GSet("gas_limit", vmGasLimit)
 	}
 
+	i.settleDelaytx()
+
 	endTime := time.Now()
 	ilog.Debugf("tx %v time %v", i.t.Actions, endTime.Sub(startTime))
 	return i.tr, nil
@@ -239,6 +298,7 @@ func (i *Isolator) PayCost() (*tx.TxReceipt, error) {
 		i.tr.RAMUsage = make(map[string]int64)
 		i.tr.Status.Code = tx.ErrorBalanceNotEnough
 		i.tr.Status.Message = "balance not enough after executing actions: " + err.Error()
+		i.settleDelaytx()
 		paidGas, err = i.h.DoPay(i.t.GasRatio)
 		if err != nil {
 			return nil, err
@@ -254,9 +314,39 @@ func (i *Isolator) PayCost() (*tx.TxReceipt, error) {
 	return i.tr, nil
 }
 
-// Commit flush changes to db
+// Commit flush changes to db, then posts the events of tx with its final status
 func (i *Isolator) Commit() {
 	i.h.DB().Commit()
+	if i.tr == nil {
+		return
+	}
+	events := i.h.TakeKeptEvents(i.tr.Status.Code)
+	if !i.replayMode {
+		events.Post()
+	}
+}
+
+// TakeEvents takes the events of tx with its status after PayCost, so that the caller posts them instead of Commit
+func (i *Isolator) TakeEvents() host.KeptEvents {
+	return i.h.TakeKeptEvents(i.tr.Status.Code)
+}
+
+// SetTracer traces the calls of the tx
+func (i *Isolator) SetTracer(t *host.Tracer) {
+	i.h.SetTracer(t)
+}
+
+// AmountTotal returns the amounts of tokens charged against the amount limit of the tx by its actions
+func (i *Isolator) AmountTotal() map[string]*common.Decimal {
+	if amounts, ok := i.h.Context().GValue("amount_total").(map[string]*common.Decimal); ok {
+		return amounts
+	}
+	return nil
+}
+
+// StateDiff returns the state changes of this tx to be committed
+func (i *Isolator) StateDiff() []*tx.StateChange {
+	return i.h.DB().StateDiff()
 }
 
 // ClearAll clear this isolator
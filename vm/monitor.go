@@ -17,6 +17,7 @@ import (
 	"github.com/iost-official/go-iost/v3/vm/host"
 	"github.com/iost-official/go-iost/v3/vm/native"
 	v8 "github.com/iost-official/go-iost/v3/vm/v8vm"
+	"github.com/iost-official/go-iost/v3/vm/wasm"
 )
 
 // Monitor ...
@@ -34,6 +35,16 @@ func NewMonitor() *Monitor {
 	return m
 }
 
+// vm returns the vm of lang, which is created on first use.
+func (m *Monitor) vm(lang string) VM {
+	vm, ok := m.vms[lang]
+	if !ok {
+		vm = Factory(lang)
+		m.vms[lang] = vm
+	}
+	return vm
+}
+
 func (m *Monitor) prepareContract(h *host.Host, contractName, api, jarg string) (c *contract.Contract, abi *contract.ABI, args []any, err error) {
 	var cid string
 	if h.IsDomain(contractName) {
@@ -95,15 +106,28 @@ func getAmountLimitMap(h *host.Host, amountList []*contract.Amount) (map[string]
 // Call ...
 // nolint
 func (m *Monitor) Call(h *host.Host, contractName, api string, jarg string) (rtn []any, cost contract.Cost, err error) {
+	var ramCost contract.Cost
+	if t := h.Tracer(); t != nil {
+		t.Enter(contractName, api, jarg)
+		defer func() {
+			t.Exit(rtn, cost, ramCost, err)
+		}()
+	}
 	// TODO: reorganize monitor to remove this code
 	callerName := h.Caller().Name
 	if api == "init" && callerName != "system.iost" && callerName != "" {
 		return nil, host.CommonErrorCost(1), errors.New("prepare contract: cannot call 'init' manually")
 	}
+	if api == "migrate" && h.IsFork3_14_0 && callerName != "system.iost" {
+		return nil, host.CommonErrorCost(1), errors.New("prepare contract: cannot call 'migrate' manually")
+	}
 	c, abi, args, err := m.prepareContract(h, contractName, api, jarg)
 	if err != nil {
 		return nil, host.Costs["GetCost"], fmt.Errorf("prepare contract: %v", err)
 	}
+	if c.Info.Lang == "wasm" && !h.IsFork3_13_0 {
+		return nil, host.Costs["GetCost"], fmt.Errorf("prepare contract: %v", host.ErrLangUnsupported)
+	}
 	cost = contract.Cost0()
 
 	h.SetStackInfo(c.ID, api)
@@ -114,11 +138,7 @@ func (m *Monitor) Call(h *host.Host, contractName, api string, jarg string) (rtn
 		cost.AddAssign(host.Costs["JSCost"])
 	}
 
-	vm, ok := m.vms[c.Info.Lang]
-	if !ok {
-		vm = Factory(c.Info.Lang)
-		m.vms[c.Info.Lang] = vm
-	}
+	vm := m.vm(c.Info.Lang)
 	currentDeadline := h.Deadline()
 	h.SetDeadline(currentDeadline.Add(-100 * time.Microsecond))
 
@@ -217,6 +237,7 @@ func (m *Monitor) Call(h *host.Host, contractName, api string, jarg string) (rtn
 	}
 	// check ram auth
 	cacheCost := h.CacheCost()
+	ramCost = cacheCost
 	h.FlushCacheCost()
 	payer := make(map[string]bool)
 	for _, c := range cacheCost.DataList {
@@ -246,6 +267,8 @@ func (m *Monitor) Compile(con *contract.Contract) (string, error) {
 	case "javascript":
 		jsvm := m.vms["javascript"]
 		return jsvm.Compile(con)
+	case "wasm":
+		return m.vm("wasm").Compile(con)
 	}
 	return "", errors.New("vm unsupported")
 }
@@ -258,6 +281,8 @@ func (m *Monitor) Validate(con *contract.Contract) error {
 	case "javascript":
 		jsvm := m.vms["javascript"]
 		return jsvm.Validate(con)
+	case "wasm":
+		return m.vm("wasm").Validate(con)
 	}
 	return errors.New("vm unsupported")
 }
@@ -274,6 +299,10 @@ func Factory(lang string) VM {
 		vm.Init()
 		//vm.SetJSPath(jsPath)
 		return vm
+	case "wasm":
+		vm := wasm.NewVM()
+		vm.Init()
+		return vm
 	}
 	return nil
 }
@@ -3,9 +3,11 @@ package native
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/bitly/go-simplejson"
 	"github.com/iost-official/go-iost/v3/core/contract"
+	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/vm/host"
 )
 
@@ -221,7 +223,25 @@ var (
 		name: "cancelDelaytx",
 		args: []string{"string"},
 		do: func(h *host.Host, args ...any) (rtn []any, cost contract.Cost, err error) {
-			return []any{}, host.Costs["GetCost"], errors.New("delaytx not exists")
+			cost = host.Costs["GetCost"]
+			hash := args[0].(string)
+			encoded := h.DB().Delaytx(hash)
+			if encoded == "" {
+				return []any{}, cost, errors.New("delaytx not exists")
+			}
+			t := &tx.Tx{}
+			err = t.Decode([]byte(encoded))
+			if err != nil {
+				return []any{}, cost, err
+			}
+			ok, cost0 := h.RequireAuth(t.Publisher, "active")
+			cost.AddAssign(cost0)
+			if !ok {
+				return []any{}, cost, errors.New("cancel delaytx need the active permission of its publisher")
+			}
+			h.DB().DelDelaytx(hash)
+			cost.AddAssign(host.Costs["DelCost"])
+			return []any{}, cost, nil
 		},
 	}
 
@@ -236,6 +256,17 @@ var (
 			if !ok {
 				return nil, cost, errors.New("set host settings need admin@system permission")
 			}
+			if h.IsFork3_12_0 {
+				var s host.Setting
+				if err := json.Unmarshal([]byte(args[0].(string)), &s); err != nil {
+					return nil, cost, fmt.Errorf("invalid host settings: %v", err)
+				}
+				if s.Block != nil {
+					if err := s.Block.Validate(); err != nil {
+						return nil, cost, err
+					}
+				}
+			}
 
 			cost0, _ = h.MapPut("settings", "host", args[0])
 			cost.AddAssign(cost0)
@@ -31,6 +31,10 @@ func goConsoleLog(cSbx C.SandboxPtr, logLevel, logDetail C.CStr) *C.char {
 	levelStr := GoString(logLevel)
 	detailStr := GoString(logDetail)
 
+	if t := sbx.host.Tracer(); t != nil {
+		t.Log(levelStr, detailStr)
+	}
+
 	if sbx.host.Logger() == nil {
 		return C.CString(ErrConsoleNoLogger.Error())
 	}
@@ -0,0 +1,310 @@
+package wasm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/contract"
+	"github.com/iost-official/go-iost/v3/crypto"
+	"github.com/iost-official/go-iost/v3/vm/database"
+	"github.com/iost-official/go-iost/v3/vm/host"
+)
+
+// the module name of the host functions imported by contracts
+const hostModuleName = "iost"
+
+const cryptGasBase = 100
+
+// errors of host functions
+var (
+	ErrMemoryAccess     = errors.New("memory access out of bounds")
+	ErrInvalidDBValType = errors.New("invalid db value type")
+	ErrNoCallState      = errors.New("call state not found")
+)
+
+type callStateKey struct{}
+
+// callState is the state of a contract call, which is shared by the host functions it calls.
+type callState struct {
+	host  *host.Host
+	input string
+	// buf keeps the last result of host functions, which is read by the contract by result
+	buf string
+	ret string
+	err error
+}
+
+func getCallState(ctx context.Context) *callState {
+	st, ok := ctx.Value(callStateKey{}).(*callState)
+	if !ok {
+		panic(ErrNoCallState)
+	}
+	return st
+}
+
+// abort stops the contract with err.
+func (st *callState) abort(err error) {
+	st.err = err
+	panic(err)
+}
+
+// charge charges the gas of the host function from the gas left of the contract.
+func (st *callState) charge(m api.Module, cost contract.Cost) {
+	gas := m.ExportedGlobal(gasGlobalName).(api.MutableGlobal)
+	left := int64(gas.Get()) - cost.CPU
+	gas.Set(uint64(left))
+	if left < 0 {
+		st.abort(host.ErrOutOfGas)
+	}
+}
+
+func (st *callState) read(m api.Module, ptr, size uint32) string {
+	b, ok := m.Memory().Read(ptr, size)
+	if !ok {
+		st.abort(ErrMemoryAccess)
+	}
+	return string(b)
+}
+
+func (st *callState) write(m api.Module, ptr uint32, s string) {
+	if !m.Memory().WriteString(ptr, s) {
+		st.abort(ErrMemoryAccess)
+	}
+}
+
+// setResult keeps s as the result of the host function, and returns its length.
+func (st *callState) setResult(s string) int32 {
+	st.buf = s
+	return int32(len(s))
+}
+
+func dbValToString(val any) (string, error) {
+	switch v := val.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case []byte:
+		return string(v), nil
+	case database.SerializedJSON:
+		return string(v), nil
+	default:
+		return "", ErrInvalidDBValType
+	}
+}
+
+func boolToI32(b bool) int32 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// instantiateHostModule instantiates the host functions imported by contracts, they mirror the bindings of v8vm.
+// Strings are passed by pointer and length in the memory of contracts. The functions returning a string keep it
+// in the call state and return its length, or -1 if it is nil, then the contract copies it to its memory by result.
+func instantiateHostModule(ctx context.Context, r wazero.Runtime) (api.Module, error) {
+	b := r.NewHostModuleBuilder(hostModuleName)
+	export := func(name string, fn any) {
+		b.NewFunctionBuilder().WithFunc(fn).Export(name)
+	}
+
+	export("input_size", func(ctx context.Context) int32 {
+		return int32(len(getCallState(ctx).input))
+	})
+	export("input", func(ctx context.Context, m api.Module, ptr uint32) {
+		st := getCallState(ctx)
+		st.write(m, ptr, st.input)
+	})
+	export("result", func(ctx context.Context, m api.Module, ptr uint32) {
+		st := getCallState(ctx)
+		st.write(m, ptr, st.buf)
+	})
+	export("ret", func(ctx context.Context, m api.Module, ptr, size uint32) {
+		st := getCallState(ctx)
+		st.ret = st.read(m, ptr, size)
+	})
+	export("abort", func(ctx context.Context, m api.Module, ptr, size uint32) {
+		st := getCallState(ctx)
+		st.abort(errors.New(st.read(m, ptr, size)))
+	})
+
+	export("block_info", func(ctx context.Context, m api.Module) int32 {
+		st := getCallState(ctx)
+		info, cost := st.host.BlockInfo()
+		st.charge(m, cost)
+		return st.setResult(string(info))
+	})
+	export("tx_info", func(ctx context.Context, m api.Module) int32 {
+		st := getCallState(ctx)
+		info, cost := st.host.TxInfo()
+		st.charge(m, cost)
+		return st.setResult(string(info))
+	})
+	export("context_info", func(ctx context.Context, m api.Module) int32 {
+		st := getCallState(ctx)
+		info, cost := st.host.ContextInfo()
+		st.charge(m, cost)
+		return st.setResult(string(info))
+	})
+
+	call := func(withAuth bool) func(context.Context, api.Module, uint32, uint32, uint32, uint32, uint32, uint32) int32 {
+		return func(ctx context.Context, m api.Module, cPtr, cSize, aPtr, aSize, argsPtr, argsSize uint32) int32 {
+			st := getCallState(ctx)
+			c, a, args := st.read(m, cPtr, cSize), st.read(m, aPtr, aSize), st.read(m, argsPtr, argsSize)
+			var (
+				rtn  []any
+				cost contract.Cost
+				err  error
+			)
+			if withAuth {
+				rtn, cost, err = st.host.CallWithAuth(c, a, args)
+			} else {
+				rtn, cost, err = st.host.Call(c, a, args)
+			}
+			st.charge(m, cost)
+			if err != nil {
+				st.abort(err)
+			}
+			rs, err := json.Marshal(rtn)
+			if err != nil {
+				st.abort(host.ErrInvalidData)
+			}
+			return st.setResult(string(rs))
+		}
+	}
+	export("call", call(false))
+	export("call_with_auth", call(true))
+	export("require_auth", func(ctx context.Context, m api.Module, idPtr, idSize, permPtr, permSize uint32) int32 {
+		st := getCallState(ctx)
+		ok, cost := st.host.RequireAuth(st.read(m, idPtr, idSize), st.read(m, permPtr, permSize))
+		st.charge(m, cost)
+		return boolToI32(ok)
+	})
+	export("receipt", func(ctx context.Context, m api.Module, ptr, size uint32) {
+		st := getCallState(ctx)
+		st.charge(m, st.host.Receipt(st.read(m, ptr, size)))
+	})
+	export("event", func(ctx context.Context, m api.Module, ptr, size uint32) {
+		st := getCallState(ctx)
+		st.charge(m, st.host.PostEvent(st.read(m, ptr, size)))
+	})
+
+	export("storage_put", func(ctx context.Context, m api.Module, kPtr, kSize, vPtr, vSize, pPtr, pSize uint32) {
+		st := getCallState(ctx)
+		k, v := st.read(m, kPtr, kSize), st.read(m, vPtr, vSize)
+		var (
+			cost contract.Cost
+			err  error
+		)
+		if payer := st.read(m, pPtr, pSize); payer == "" {
+			cost, err = st.host.Put(k, v)
+		} else {
+			cost, err = st.host.Put(k, v, payer)
+		}
+		st.charge(m, cost)
+		if err != nil {
+			st.abort(err)
+		}
+	})
+	export("storage_get", func(ctx context.Context, m api.Module, kPtr, kSize uint32) int32 {
+		st := getCallState(ctx)
+		val, cost := st.host.Get(st.read(m, kPtr, kSize))
+		st.charge(m, cost)
+		if val == nil {
+			return -1
+		}
+		s, err := dbValToString(val)
+		if err != nil {
+			st.abort(err)
+		}
+		return st.setResult(s)
+	})
+	export("storage_has", func(ctx context.Context, m api.Module, kPtr, kSize uint32) int32 {
+		st := getCallState(ctx)
+		ok, cost := st.host.Has(st.read(m, kPtr, kSize))
+		st.charge(m, cost)
+		return boolToI32(ok)
+	})
+	export("storage_del", func(ctx context.Context, m api.Module, kPtr, kSize uint32) {
+		st := getCallState(ctx)
+		cost, err := st.host.Del(st.read(m, kPtr, kSize))
+		st.charge(m, cost)
+		if err != nil {
+			st.abort(err)
+		}
+	})
+	export("storage_map_put", func(ctx context.Context, m api.Module, kPtr, kSize, fPtr, fSize, vPtr, vSize, pPtr, pSize uint32) {
+		st := getCallState(ctx)
+		k, f, v := st.read(m, kPtr, kSize), st.read(m, fPtr, fSize), st.read(m, vPtr, vSize)
+		var (
+			cost contract.Cost
+			err  error
+		)
+		if payer := st.read(m, pPtr, pSize); payer == "" {
+			cost, err = st.host.MapPut(k, f, v)
+		} else {
+			cost, err = st.host.MapPut(k, f, v, payer)
+		}
+		st.charge(m, cost)
+		if err != nil {
+			st.abort(err)
+		}
+	})
+	export("storage_map_get", func(ctx context.Context, m api.Module, kPtr, kSize, fPtr, fSize uint32) int32 {
+		st := getCallState(ctx)
+		val, cost := st.host.MapGet(st.read(m, kPtr, kSize), st.read(m, fPtr, fSize))
+		st.charge(m, cost)
+		if val == nil {
+			return -1
+		}
+		s, err := dbValToString(val)
+		if err != nil {
+			st.abort(err)
+		}
+		return st.setResult(s)
+	})
+	export("storage_map_has", func(ctx context.Context, m api.Module, kPtr, kSize, fPtr, fSize uint32) int32 {
+		st := getCallState(ctx)
+		ok, cost := st.host.MapHas(st.read(m, kPtr, kSize), st.read(m, fPtr, fSize))
+		st.charge(m, cost)
+		return boolToI32(ok)
+	})
+	export("storage_map_del", func(ctx context.Context, m api.Module, kPtr, kSize, fPtr, fSize uint32) {
+		st := getCallState(ctx)
+		cost, err := st.host.MapDel(st.read(m, kPtr, kSize), st.read(m, fPtr, fSize))
+		st.charge(m, cost)
+		if err != nil {
+			st.abort(err)
+		}
+	})
+
+	export("sha3", func(ctx context.Context, m api.Module, ptr, size uint32) int32 {
+		st := getCallState(ctx)
+		msg := st.read(m, ptr, size)
+		st.charge(m, contract.NewCost(0, 0, int64(len(msg)+cryptGasBase)))
+		return st.setResult(common.Base58Encode(common.Sha3([]byte(msg))))
+	})
+	export("verify", func(ctx context.Context, m api.Module, algoPtr, algoSize, msgPtr, msgSize, sigPtr, sigSize, pubPtr, pubSize uint32) int32 {
+		st := getCallState(ctx)
+		algo := st.read(m, algoPtr, algoSize)
+		msg := common.Base58Decode(st.read(m, msgPtr, msgSize))
+		sig := common.Base58Decode(st.read(m, sigPtr, sigSize))
+		pubkey := common.Base58Decode(st.read(m, pubPtr, pubSize))
+		st.charge(m, contract.NewCost(0, 0, int64(len(msg)+cryptGasBase)))
+		if algo != "secp256k1" && algo != "ed25519" {
+			return 0
+		}
+		return boolToI32(crypto.NewAlgorithm(algo).Verify(msg, pubkey, sig))
+	})
+
+	return b.Instantiate(ctx)
+}
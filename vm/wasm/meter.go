@@ -0,0 +1,520 @@
+package wasm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// the gas left of the running contract is kept in an injected mutable global, which is exported by this name
+const gasGlobalName = "__gas"
+
+// instructionCost is the gas charged for each instruction
+const instructionCost = 1
+
+const (
+	sectionCustom byte = iota
+	sectionType
+	sectionImport
+	sectionFunction
+	sectionTable
+	sectionMemory
+	sectionGlobal
+	sectionExport
+	sectionStart
+	sectionElement
+	sectionCode
+	sectionData
+	sectionDataCount
+)
+
+const (
+	kindFunc byte = iota
+	kindTable
+	kindMemory
+	kindGlobal
+)
+
+var wasmHeader = []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+// errors of contract code
+var (
+	ErrInvalidCode        = errors.New("invalid wasm code")
+	ErrUnsupportedOpcode  = errors.New("unsupported wasm opcode")
+	ErrUnsupportedImport  = errors.New("only functions of iost can be imported")
+	ErrStartFunction      = errors.New("start function is not allowed")
+	ErrReservedExportName = errors.New("export " + gasGlobalName + " is reserved")
+)
+
+type section struct {
+	id   byte
+	body []byte
+}
+
+type reader struct {
+	b   []byte
+	pos int
+}
+
+func (r *reader) eof() bool {
+	return r.pos >= len(r.b)
+}
+
+func (r *reader) byte() (byte, error) {
+	if r.pos >= len(r.b) {
+		return 0, ErrInvalidCode
+	}
+	b := r.b[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *reader) bytes(n uint32) ([]byte, error) {
+	if uint64(r.pos)+uint64(n) > uint64(len(r.b)) {
+		return nil, ErrInvalidCode
+	}
+	b := r.b[r.pos : r.pos+int(n)]
+	r.pos += int(n)
+	return b, nil
+}
+
+func (r *reader) u32() (uint32, error) {
+	var v uint32
+	for i := 0; i < 5; i++ {
+		b, err := r.byte()
+		if err != nil {
+			return 0, err
+		}
+		v |= uint32(b&0x7f) << (7 * i)
+		if b&0x80 == 0 {
+			return v, nil
+		}
+	}
+	return 0, ErrInvalidCode
+}
+
+// skipLEB skips a signed leb128 number of at most maxBytes bytes
+func (r *reader) skipLEB(maxBytes int) error {
+	for i := 0; i < maxBytes; i++ {
+		b, err := r.byte()
+		if err != nil {
+			return err
+		}
+		if b&0x80 == 0 {
+			return nil
+		}
+	}
+	return ErrInvalidCode
+}
+
+func (r *reader) name() (string, error) {
+	n, err := r.u32()
+	if err != nil {
+		return "", err
+	}
+	b, err := r.bytes(n)
+	return string(b), err
+}
+
+func appendU32(b []byte, v uint32) []byte {
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if v != 0 {
+			b = append(b, c|0x80)
+		} else {
+			return append(b, c)
+		}
+	}
+}
+
+func appendS64(b []byte, v int64) []byte {
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && c&0x40 == 0) || (v == -1 && c&0x40 != 0) {
+			return append(b, c)
+		}
+		b = append(b, c|0x80)
+	}
+}
+
+func parseSections(code []byte) ([]section, error) {
+	if !bytes.HasPrefix(code, wasmHeader) {
+		return nil, ErrInvalidCode
+	}
+	r := &reader{b: code, pos: len(wasmHeader)}
+	sections := make([]section, 0)
+	for !r.eof() {
+		id, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		size, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		body, err := r.bytes(size)
+		if err != nil {
+			return nil, err
+		}
+		sections = append(sections, section{id: id, body: body})
+	}
+	return sections, nil
+}
+
+// sectionOrder returns the position of a non custom section in the module
+func sectionOrder(id byte) int {
+	if id == sectionDataCount {
+		// the data count section is between the element and code sections
+		return int(sectionElement) + 1
+	}
+	if id >= sectionCode {
+		return int(id) + 1
+	}
+	return int(id)
+}
+
+// instrument injects gas metering into the contract code. The gas left is kept in the exported global __gas, it is
+// charged at the entry of every function and every loop iteration by the instructions of the function or the loop,
+// and the contract traps when it is negative.
+func instrument(code []byte) ([]byte, error) {
+	sections, err := parseSections(code)
+	if err != nil {
+		return nil, err
+	}
+	var globalCount uint32
+	hasGlobal, hasExport := false, false
+	for _, s := range sections {
+		switch s.id {
+		case sectionImport:
+			if err := checkImports(s.body); err != nil {
+				return nil, err
+			}
+		case sectionStart:
+			return nil, ErrStartFunction
+		case sectionGlobal:
+			r := &reader{b: s.body}
+			if globalCount, err = r.u32(); err != nil {
+				return nil, err
+			}
+			hasGlobal = true
+		case sectionExport:
+			hasExport = true
+		}
+	}
+	// no global is imported, so the injected global is after the defined ones
+	gasIdx := globalCount
+
+	ret := append([]byte{}, wasmHeader...)
+	appendSection := func(id byte, body []byte) {
+		ret = append(ret, id)
+		ret = appendU32(ret, uint32(len(body)))
+		ret = append(ret, body...)
+	}
+	for _, s := range sections {
+		if !hasGlobal && s.id != sectionCustom && sectionOrder(s.id) > sectionOrder(sectionGlobal) {
+			appendSection(sectionGlobal, appendGasGlobal(appendU32(nil, 1)))
+			hasGlobal = true
+		}
+		if !hasExport && s.id != sectionCustom && sectionOrder(s.id) > sectionOrder(sectionExport) {
+			appendSection(sectionExport, appendGasExport(appendU32(nil, 1), gasIdx))
+			hasExport = true
+		}
+		body := s.body
+		switch s.id {
+		case sectionGlobal:
+			r := &reader{b: s.body}
+			r.u32() // nolint
+			body = appendGasGlobal(appendU32(nil, globalCount+1), s.body[r.pos:]...)
+		case sectionExport:
+			if body, err = instrumentExports(s.body, gasIdx); err != nil {
+				return nil, err
+			}
+		case sectionCode:
+			if body, err = instrumentCode(s.body, gasIdx); err != nil {
+				return nil, err
+			}
+		}
+		appendSection(s.id, body)
+	}
+	if !hasGlobal {
+		appendSection(sectionGlobal, appendGasGlobal(appendU32(nil, 1)))
+	}
+	if !hasExport {
+		appendSection(sectionExport, appendGasExport(appendU32(nil, 1), gasIdx))
+	}
+	return ret, nil
+}
+
+func checkImports(body []byte) error {
+	r := &reader{b: body}
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < n; i++ {
+		module, err := r.name()
+		if err != nil {
+			return err
+		}
+		if _, err := r.name(); err != nil {
+			return err
+		}
+		kind, err := r.byte()
+		if err != nil {
+			return err
+		}
+		if module != hostModuleName || kind != kindFunc {
+			return ErrUnsupportedImport
+		}
+		if _, err := r.u32(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendGasGlobal appends the globals and the gas global as mutable i64 initialized with 0
+func appendGasGlobal(b []byte, globals ...byte) []byte {
+	b = append(b, globals...)
+	return append(b, 0x7e, 0x01, 0x42, 0x00, 0x0b)
+}
+
+func appendGasExport(b []byte, gasIdx uint32) []byte {
+	b = appendU32(b, uint32(len(gasGlobalName)))
+	b = append(b, gasGlobalName...)
+	b = append(b, kindGlobal)
+	return appendU32(b, gasIdx)
+}
+
+func instrumentExports(body []byte, gasIdx uint32) ([]byte, error) {
+	r := &reader{b: body}
+	n, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	exports := r.pos
+	for i := uint32(0); i < n; i++ {
+		name, err := r.name()
+		if err != nil {
+			return nil, err
+		}
+		if name == gasGlobalName {
+			return nil, ErrReservedExportName
+		}
+		if _, err := r.byte(); err != nil {
+			return nil, err
+		}
+		if _, err := r.u32(); err != nil {
+			return nil, err
+		}
+	}
+	return appendGasExport(append(appendU32(nil, n+1), body[exports:]...), gasIdx), nil
+}
+
+func instrumentCode(body []byte, gasIdx uint32) ([]byte, error) {
+	r := &reader{b: body}
+	n, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	ret := appendU32(nil, n)
+	for i := uint32(0); i < n; i++ {
+		size, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		fn, err := r.bytes(size)
+		if err != nil {
+			return nil, err
+		}
+		fn, err = instrumentFunc(fn, gasIdx)
+		if err != nil {
+			return nil, fmt.Errorf("function %v: %v", i, err)
+		}
+		ret = appendU32(ret, uint32(len(fn)))
+		ret = append(ret, fn...)
+	}
+	if !r.eof() {
+		return nil, ErrInvalidCode
+	}
+	return ret, nil
+}
+
+// appendCharge appends the instructions to charge cost from the gas global, and to trap if the gas is negative
+func appendCharge(b []byte, gasIdx uint32, cost int64) []byte {
+	b = appendU32(append(b, 0x23), gasIdx) // global.get
+	b = appendS64(append(b, 0x42), cost)   // i64.const
+	b = append(b, 0x7d)                    // i64.sub
+	b = appendU32(append(b, 0x24), gasIdx) // global.set
+	b = appendU32(append(b, 0x23), gasIdx) // global.get
+	b = append(b, 0x42, 0x00)              // i64.const 0
+	b = append(b, 0x53)                    // i64.lt_s
+	b = append(b, 0x04, 0x40)              // if
+	b = append(b, 0x00)                    // unreachable
+	return append(b, 0x0b)                 // end
+}
+
+func instrumentFunc(fn []byte, gasIdx uint32) ([]byte, error) {
+	r := &reader{b: fn}
+	n, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	for i := uint32(0); i < n; i++ {
+		if _, err := r.u32(); err != nil {
+			return nil, err
+		}
+		if _, err := r.byte(); err != nil {
+			return nil, err
+		}
+	}
+	localsEnd := r.pos
+
+	// costs[0] is charged at the function entry, the others are charged at the start of loops.
+	// every instruction is charged by the innermost loop containing it.
+	costs := []int64{0}
+	loopEnds := make([]int, 0)
+	meters := []int{0}
+	for len(meters) > 0 {
+		op, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		costs[meters[len(meters)-1]] += instructionCost
+		if err := skipImmediates(r, op); err != nil {
+			return nil, err
+		}
+		switch op {
+		case 0x02, 0x04: // block, if
+			meters = append(meters, meters[len(meters)-1])
+		case 0x03: // loop
+			meters = append(meters, len(costs))
+			costs = append(costs, 0)
+			loopEnds = append(loopEnds, r.pos)
+		case 0x0b: // end
+			meters = meters[:len(meters)-1]
+		}
+	}
+	if !r.eof() {
+		return nil, ErrInvalidCode
+	}
+
+	ret := append([]byte{}, fn[:localsEnd]...)
+	ret = appendCharge(ret, gasIdx, costs[0])
+	pos := localsEnd
+	for i, end := range loopEnds {
+		ret = append(ret, fn[pos:end]...)
+		ret = appendCharge(ret, gasIdx, costs[i+1])
+		pos = end
+	}
+	return append(ret, fn[pos:]...), nil
+}
+
+// skipImmediates skips the immediates of the instruction. Floating point, SIMD and other proposals not in wasm 2.0
+// are unsupported, to keep the execution deterministic.
+func skipImmediates(r *reader, op byte) error { // nolint:gocyclo
+	switch {
+	case op == 0x00 || op == 0x01 || op == 0x05 || op == 0x0b || op == 0x0f || op == 0x1a || op == 0x1b:
+		return nil
+	case op == 0x02 || op == 0x03 || op == 0x04: // block type
+		b, err := r.byte()
+		if err != nil {
+			return err
+		}
+		if b == 0x40 || (b >= 0x6f && b <= 0x7f) {
+			// empty or value type
+			return nil
+		}
+		r.pos--
+		return r.skipLEB(5)
+	case op == 0x0c || op == 0x0d || op == 0x10 || (op >= 0x20 && op <= 0x26) || op == 0xd2:
+		_, err := r.u32()
+		return err
+	case op == 0x0e: // br_table
+		n, err := r.u32()
+		if err != nil {
+			return err
+		}
+		for i := uint32(0); i <= n; i++ {
+			if _, err := r.u32(); err != nil {
+				return err
+			}
+		}
+		return nil
+	case op == 0x11: // call_indirect
+		if _, err := r.u32(); err != nil {
+			return err
+		}
+		_, err := r.u32()
+		return err
+	case op == 0x1c: // select t*
+		n, err := r.u32()
+		if err != nil {
+			return err
+		}
+		_, err = r.bytes(n)
+		return err
+	case op == 0x2a || op == 0x2b || op == 0x38 || op == 0x39: // float load and store
+		return ErrUnsupportedOpcode
+	case op >= 0x28 && op <= 0x3e: // memarg
+		if _, err := r.u32(); err != nil {
+			return err
+		}
+		_, err := r.u32()
+		return err
+	case op == 0x3f || op == 0x40 || op == 0xd0: // memory.size, memory.grow, ref.null
+		_, err := r.byte()
+		return err
+	case op == 0x41:
+		return r.skipLEB(5)
+	case op == 0x42:
+		return r.skipLEB(10)
+	case op >= 0x45 && op <= 0x5a: // integer comparison
+		return nil
+	case op >= 0x67 && op <= 0x8a: // integer arithmetic
+		return nil
+	case op == 0xa7 || op == 0xac || op == 0xad: // integer conversion
+		return nil
+	case op >= 0xc0 && op <= 0xc4: // sign extension
+		return nil
+	case op == 0xd1:
+		return nil
+	case op == 0xfc:
+		return skipMiscImmediates(r)
+	}
+	return ErrUnsupportedOpcode
+}
+
+func skipMiscImmediates(r *reader) error {
+	sub, err := r.u32()
+	if err != nil {
+		return err
+	}
+	switch sub {
+	case 8: // memory.init
+		if _, err := r.u32(); err != nil {
+			return err
+		}
+		_, err := r.byte()
+		return err
+	case 9, 13, 15, 16, 17: // data.drop, elem.drop, table.grow, table.size, table.fill
+		_, err := r.u32()
+		return err
+	case 10: // memory.copy
+		_, err := r.bytes(2)
+		return err
+	case 11: // memory.fill
+		_, err := r.byte()
+		return err
+	case 12, 14: // table.init, table.copy
+		if _, err := r.u32(); err != nil {
+			return err
+		}
+		_, err := r.u32()
+		return err
+	}
+	// the saturating truncations of floats
+	return ErrUnsupportedOpcode
+}
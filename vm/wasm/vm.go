@@ -0,0 +1,260 @@
+package wasm
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/contract"
+	"github.com/iost-official/go-iost/v3/vm/host"
+)
+
+const (
+	// the max memory of a contract is 2MB
+	maxMemoryPages = 32
+	// the number of compiled contracts kept in cache
+	moduleCacheSize = 256
+)
+
+// errors of wasm vm
+var (
+	ErrNoMemory    = errors.New("memory should be exported")
+	ErrInvalidABI  = errors.New("abi should be an exported function without params and results")
+	ErrUnknownHost = errors.New("unknown host function")
+	ErrNotCompiled = errors.New("wasm code is not compiled")
+)
+
+// VM runs contracts compiled to WebAssembly by the wazero interpreter. The contract code is base64 encoded.
+//
+// Every abi of the contract is an exported function without params and results, it reads the args in json array by
+// the host functions input_size and input, and sets the return value by ret.
+type VM struct {
+	runtime wazero.Runtime
+	hostFns map[string]api.FunctionDefinition
+
+	// the lock prevents a compiled module from being closed by eviction when it is instantiated
+	lock    sync.RWMutex
+	modules *lru.Cache[string, wazero.CompiledModule]
+}
+
+// NewVM returns a wasm vm.
+func NewVM() *VM {
+	return &VM{}
+}
+
+// Init creates the runtime with the host functions.
+func (v *VM) Init() error {
+	ctx := context.Background()
+	config := wazero.NewRuntimeConfigInterpreter().
+		WithCoreFeatures(api.CoreFeaturesV2.SetEnabled(api.CoreFeatureSIMD, false)).
+		WithMemoryLimitPages(maxMemoryPages).
+		WithCloseOnContextDone(true)
+	v.runtime = wazero.NewRuntimeWithConfig(ctx, config)
+	hostModule, err := instantiateHostModule(ctx, v.runtime)
+	if err != nil {
+		return err
+	}
+	v.hostFns = hostModule.ExportedFunctionDefinitions()
+	v.modules, err = lru.NewWithEvict(moduleCacheSize, func(_ string, m wazero.CompiledModule) {
+		m.Close(ctx) // nolint
+	})
+	return err
+}
+
+func decodeCode(c *contract.Contract) ([]byte, error) {
+	code, err := base64.StdEncoding.DecodeString(c.Code)
+	if err != nil {
+		return nil, fmt.Errorf("wasm code should be base64 encoded: %v", err)
+	}
+	return code, nil
+}
+
+// Validate checks the imports, exports and instructions of the contract code.
+func (v *VM) Validate(c *contract.Contract) error {
+	code, err := decodeCode(c)
+	if err != nil {
+		return err
+	}
+	if _, err := instrument(code); err != nil {
+		return err
+	}
+	m, err := v.runtime.CompileModule(context.Background(), code)
+	if err != nil {
+		return err
+	}
+	defer m.Close(context.Background()) // nolint
+
+	for _, fn := range m.ImportedFunctions() {
+		_, name, _ := fn.Import()
+		hostFn, ok := v.hostFns[name]
+		if !ok || !sameSignature(fn, hostFn) {
+			return fmt.Errorf("%v: %v", ErrUnknownHost, name)
+		}
+	}
+	if _, ok := m.ExportedMemories()["memory"]; !ok {
+		return ErrNoMemory
+	}
+	fns := m.ExportedFunctions()
+	for _, abi := range c.Info.Abi {
+		fn, ok := fns[abi.Name]
+		if !ok || len(fn.ParamTypes()) != 0 || len(fn.ResultTypes()) != 0 {
+			return fmt.Errorf("%v: %v", ErrInvalidABI, abi.Name)
+		}
+	}
+	return nil
+}
+
+func sameSignature(a, b api.FunctionDefinition) bool {
+	return string(a.ParamTypes()) == string(b.ParamTypes()) && string(a.ResultTypes()) == string(b.ResultTypes())
+}
+
+// Compile injects gas metering into the contract code.
+func (v *VM) Compile(c *contract.Contract) (string, error) {
+	code, err := decodeCode(c)
+	if err != nil {
+		return "", err
+	}
+	code, err = instrument(code)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(code), nil
+}
+
+func (v *VM) instantiate(ctx context.Context, c *contract.Contract) (api.Module, error) {
+	// modules without name can be instantiated more than once, for the contract may be called again in a call
+	config := wazero.NewModuleConfig().WithName("").WithStartFunctions()
+	key := string(common.Sha3([]byte(c.Code)))
+	v.lock.RLock()
+	if compiled, ok := v.modules.Get(key); ok {
+		defer v.lock.RUnlock()
+		return v.runtime.InstantiateModule(ctx, compiled, config)
+	}
+	v.lock.RUnlock()
+
+	code, err := decodeCode(c)
+	if err != nil {
+		return nil, err
+	}
+	compiled, err := v.runtime.CompileModule(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.modules.Add(key, compiled)
+	return v.runtime.InstantiateModule(ctx, compiled, config)
+}
+
+// LoadAndCall calls the api of the contract with the gas limit and deadline of the host.
+func (v *VM) LoadAndCall(h *host.Host, c *contract.Contract, function string, args ...any) (rtn []any, cost contract.Cost, err error) {
+	if !h.Deadline().After(time.Now()) {
+		// the "execution killed" string will be matched and converted to a timeout err outside
+		return nil, contract.Cost0(), errors.New("execution killed")
+	}
+	input, err := formatFuncArgs(args)
+	if err != nil {
+		return nil, contract.Cost0(), err
+	}
+	st := &callState{host: h, input: input}
+	ctx, cancel := context.WithDeadline(context.WithValue(context.Background(), callStateKey{}, st), h.Deadline())
+	defer cancel()
+
+	m, err := v.instantiate(ctx, c)
+	if err != nil {
+		return nil, contract.Cost0(), err
+	}
+	defer m.Close(context.Background()) // nolint
+
+	fn := m.ExportedFunction(function)
+	if fn == nil {
+		if function == "init" {
+			// init is optional
+			return []any{""}, contract.Cost0(), nil
+		}
+		return nil, contract.Cost0(), fmt.Errorf("function %v not found", function)
+	}
+	gas, ok := m.ExportedGlobal(gasGlobalName).(api.MutableGlobal)
+	if !ok {
+		return nil, contract.Cost0(), ErrNotCompiled
+	}
+	gasLimit := h.GasLimitValue()
+	gas.Set(uint64(gasLimit))
+
+	_, err = fn.Call(ctx)
+	left := int64(gas.Get())
+	cost = contract.NewCost(0, 0, gasLimit-left)
+	if err != nil {
+		switch {
+		case left < 0:
+			return nil, cost, host.ErrOutOfGas
+		case st.err != nil:
+			return nil, cost, st.err
+		case ctx.Err() != nil:
+			return nil, cost, errors.New("execution killed")
+		}
+		return nil, cost, err
+	}
+	return []any{st.ret}, cost, nil
+}
+
+// Release closes the runtime.
+func (v *VM) Release() {
+	v.runtime.Close(context.Background()) // nolint
+}
+
+func formatFuncArgs(args []any) (string, error) {
+	strArgs := make([]string, 0, len(args))
+	for _, arg := range args {
+		switch a := arg.(type) {
+		case []byte:
+			strArgs = append(strArgs, string(a))
+		default:
+			b, err := json.Marshal(a)
+			if err != nil {
+				return "", err
+			}
+			strArgs = append(strArgs, string(b))
+		}
+	}
+	return "[" + strings.Join(strArgs, ",") + "]", nil
+}
+
+// GenerateABI returns the contract info of the wasm code, whose abi are the exported functions without params and
+// results. The args of abi should be filled by the author of the contract.
+func GenerateABI(code []byte) (*contract.Info, error) {
+	ctx := context.Background()
+	r := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfigInterpreter())
+	defer r.Close(ctx) // nolint
+	m, err := r.CompileModule(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0)
+	for name, fn := range m.ExportedFunctions() {
+		if len(fn.ParamTypes()) == 0 && len(fn.ResultTypes()) == 0 && name != "init" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	info := &contract.Info{
+		Lang:    "wasm",
+		Version: "1.0.0",
+		Abi:     make([]*contract.ABI, 0, len(names)),
+	}
+	for _, name := range names {
+		info.Abi = append(info.Abi, &contract.ABI{Name: name, Args: []string{}})
+	}
+	return info, nil
+}
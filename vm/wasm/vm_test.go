@@ -0,0 +1,203 @@
+package wasm
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iost-official/go-iost/v3/core/contract"
+	"github.com/iost-official/go-iost/v3/core/version"
+	"github.com/iost-official/go-iost/v3/vm/database"
+	"github.com/iost-official/go-iost/v3/vm/host"
+)
+
+type mapDB map[string]string
+
+func (m mapDB) Get(table string, key string) (string, error) {
+	if v, ok := m[table+"/"+key]; ok {
+		return v, nil
+	}
+	return "n", nil
+}
+
+func (m mapDB) Put(table string, key string, value string) error {
+	m[table+"/"+key] = value
+	return nil
+}
+
+func (m mapDB) Del(table string, key string) error {
+	delete(m, table+"/"+key)
+	return nil
+}
+
+func (m mapDB) Has(table string, key string) (bool, error) {
+	_, ok := m[table+"/"+key]
+	return ok, nil
+}
+
+func vec(items ...[]byte) []byte {
+	b := appendU32(nil, uint32(len(items)))
+	for _, item := range items {
+		b = append(b, item...)
+	}
+	return b
+}
+
+func withSize(b []byte) []byte {
+	return append(appendU32(nil, uint32(len(b))), b...)
+}
+
+func name(s string) []byte {
+	return withSize([]byte(s))
+}
+
+func cat(bs ...[]byte) []byte {
+	ret := make([]byte, 0)
+	for _, b := range bs {
+		ret = append(ret, b...)
+	}
+	return ret
+}
+
+func sec(id byte, body []byte) []byte {
+	return cat([]byte{id}, withSize(body))
+}
+
+func funcType(params, results int) []byte {
+	b := []byte{0x60}
+	b = appendU32(b, uint32(params))
+	for i := 0; i < params; i++ {
+		b = append(b, 0x7f)
+	}
+	b = appendU32(b, uint32(results))
+	for i := 0; i < results; i++ {
+		b = append(b, 0x7f)
+	}
+	return b
+}
+
+// testModule returns a contract importing the host functions, with the functions:
+// echo returns the input, loop never returns, save puts the input to key "k", and load returns the value of "k".
+func testModule() []byte {
+	imp := func(fn string, typ byte) []byte {
+		return cat(name("iost"), name(fn), []byte{kindFunc, typ})
+	}
+	exp := func(n string, kind byte, idx byte) []byte {
+		return cat(name(n), []byte{kind, idx})
+	}
+	localI32 := []byte{0x01, 0x01, 0x7f}
+	return cat(wasmHeader,
+		sec(sectionType, vec(funcType(0, 1), funcType(1, 0), funcType(2, 0), funcType(6, 0), funcType(2, 1), funcType(0, 0))),
+		sec(sectionImport, vec(imp("input_size", 0), imp("input", 1), imp("ret", 2), imp("storage_put", 3), imp("storage_get", 4), imp("result", 1))),
+		sec(sectionFunction, vec([]byte{5}, []byte{5}, []byte{5}, []byte{5})),
+		sec(sectionMemory, vec([]byte{0x00, 0x01})),
+		sec(sectionExport, vec(exp("memory", kindMemory, 0), exp("echo", kindFunc, 6), exp("loop", kindFunc, 7), exp("save", kindFunc, 8), exp("load", kindFunc, 9))),
+		sec(sectionCode, vec(
+			withSize(cat(localI32, []byte{0x10, 0, 0x21, 0, 0x41, 16, 0x10, 1, 0x41, 16, 0x20, 0, 0x10, 2, 0x0b})),
+			withSize([]byte{0x00, 0x03, 0x40, 0x0c, 0x00, 0x0b, 0x0b}),
+			withSize(cat(localI32, []byte{0x10, 0, 0x21, 0, 0x41, 16, 0x10, 1, 0x41, 0, 0x41, 1, 0x41, 16, 0x20, 0, 0x41, 0, 0x41, 0, 0x10, 3, 0x0b})),
+			withSize(cat(localI32, []byte{0x41, 0, 0x41, 1, 0x10, 4, 0x21, 0, 0x41, 16, 0x10, 5, 0x41, 16, 0x20, 0, 0x10, 2, 0x0b})),
+		)),
+		sec(sectionData, vec(cat([]byte{0x00, 0x41, 0x00, 0x0b}, name("k")))),
+	)
+}
+
+func testContract(code []byte) *contract.Contract {
+	abi := func(n string, args ...string) *contract.ABI {
+		return &contract.ABI{Name: n, Args: args}
+	}
+	return &contract.Contract{
+		ID:   "Contractwasm",
+		Code: base64.StdEncoding.EncodeToString(code),
+		Info: &contract.Info{
+			Lang:    "wasm",
+			Version: "1.0.0",
+			Abi:     []*contract.ABI{abi("echo", "string", "number"), abi("loop"), abi("save", "string"), abi("load")},
+		},
+	}
+}
+
+func newHost(gasLimit int64) *host.Host {
+	ctx := host.NewContext(nil)
+	ctx.GSet("gas_limit", gasLimit)
+	ctx.GSet("receipts", nil)
+	ctx.Set("contract_name", "Contractwasm")
+	h := host.NewHost(ctx, database.NewVisitor(0, mapDB{}, version.NewRules(0)), version.NewRules(0), nil, nil)
+	h.SetDeadline(time.Now().Add(time.Second))
+	return h
+}
+
+func TestInstrument(t *testing.T) {
+	code, err := instrument(testModule())
+	assert.NoError(t, err)
+	sections, err := parseSections(code)
+	assert.NoError(t, err)
+	ids := make([]byte, 0)
+	for _, s := range sections {
+		ids = append(ids, s.id)
+	}
+	// the global section is inserted before the export section
+	assert.Equal(t, []byte{sectionType, sectionImport, sectionFunction, sectionMemory, sectionGlobal, sectionExport, sectionCode, sectionData}, ids)
+
+	_, err = instrument(append(testModule(), sec(sectionStart, []byte{6})...))
+	assert.Equal(t, ErrStartFunction, err)
+	// f32.const is unsupported
+	_, err = instrumentFunc([]byte{0x00, 0x43, 0, 0, 0, 0, 0x1a, 0x0b}, 0)
+	assert.Equal(t, ErrUnsupportedOpcode, err)
+	// the block isn't ended
+	_, err = instrumentFunc([]byte{0x00, 0x02, 0x40, 0x0b}, 0)
+	assert.Equal(t, ErrInvalidCode, err)
+}
+
+func TestVM(t *testing.T) {
+	vm := NewVM()
+	assert.NoError(t, vm.Init())
+	defer vm.Release()
+
+	c := testContract(testModule())
+	assert.NoError(t, vm.Validate(c))
+	c.Info.Abi = append(c.Info.Abi, &contract.ABI{Name: "missing"})
+	assert.Error(t, vm.Validate(c))
+	c.Info.Abi = c.Info.Abi[:len(c.Info.Abi)-1]
+
+	code, err := vm.Compile(c)
+	assert.NoError(t, err)
+	c.Code = code
+
+	h := newHost(100000)
+	rtn, cost, err := vm.LoadAndCall(h, c, "echo", "hello", int64(1))
+	assert.NoError(t, err)
+	assert.Equal(t, []any{`["hello",1]`}, rtn)
+	assert.True(t, cost.CPU > 0)
+
+	// the gas is deterministic
+	_, cost1, err := vm.LoadAndCall(newHost(100000), c, "echo", "hello", int64(1))
+	assert.NoError(t, err)
+	assert.Equal(t, cost, cost1)
+
+	_, cost, err = vm.LoadAndCall(newHost(1000), c, "loop")
+	assert.Equal(t, host.ErrOutOfGas, err)
+	assert.True(t, cost.CPU > 1000)
+
+	_, _, err = vm.LoadAndCall(h, c, "init")
+	assert.NoError(t, err)
+
+	_, _, err = vm.LoadAndCall(h, c, "save", "value")
+	assert.NoError(t, err)
+	rtn, _, err = vm.LoadAndCall(h, c, "load")
+	assert.NoError(t, err)
+	assert.Equal(t, []any{`["value"]`}, rtn)
+}
+
+func TestGenerateABI(t *testing.T) {
+	info, err := GenerateABI(testModule())
+	assert.NoError(t, err)
+	assert.Equal(t, "wasm", info.Lang)
+	names := make([]string, 0)
+	for _, abi := range info.Abi {
+		names = append(names, abi.Name)
+	}
+	assert.Equal(t, []string{"echo", "load", "loop", "save"}, names)
+}